@@ -8,3 +8,10 @@ output "ns-present" {
   value = contains(data.kubernetes_all_namespaces.allns.namespaces, "kube-system")
 }
 
+data "kubernetes_all_namespaces" "team" {
+  label_selector = "team=platform"
+}
+
+output "team-ns-uids" {
+  value = { for ns in data.kubernetes_all_namespaces.team.items : ns.name => ns.uid }
+}
@@ -0,0 +1,23 @@
+data "kubernetes_cluster_role_binding_v1" "example" {
+  metadata {
+    name = "cluster-admin"
+  }
+}
+
+resource "kubernetes_cluster_role_binding_v1" "admins" {
+  metadata {
+    name = "terraform-example-admins"
+  }
+
+  role_ref {
+    api_group = "rbac.authorization.k8s.io"
+    kind      = "ClusterRole"
+    name      = data.kubernetes_cluster_role_binding_v1.example.role_ref.0.name
+  }
+
+  subject {
+    kind      = "Group"
+    name      = "terraform-example-admins"
+    api_group = "rbac.authorization.k8s.io"
+  }
+}
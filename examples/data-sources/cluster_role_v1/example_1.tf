@@ -0,0 +1,5 @@
+data "kubernetes_cluster_role_v1" "example" {
+  metadata {
+    name = "cluster-admin"
+  }
+}
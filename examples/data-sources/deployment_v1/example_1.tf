@@ -0,0 +1,19 @@
+data "kubernetes_deployment_v1" "example" {
+  metadata {
+    name      = "my-app"
+    namespace = "default"
+  }
+}
+
+resource "kubernetes_service_v1" "example" {
+  metadata {
+    name = "my-app"
+  }
+  spec {
+    selector = data.kubernetes_deployment_v1.example.spec.0.template.0.metadata.0.labels
+    port {
+      port        = 80
+      target_port = 8080
+    }
+  }
+}
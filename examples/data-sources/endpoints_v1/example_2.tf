@@ -1,6 +1,14 @@
-data "kubernetes_endpoints_v1" "api_endpoints" {
+data "kubernetes_endpoints_v1" "database" {
   metadata {
-    name      = "kubernetes"
+    name      = "database"
     namespace = "default"
   }
 }
+
+output "database_ips" {
+  value = flatten([
+    for subset in data.kubernetes_endpoints_v1.database.subset : [
+      for address in subset.address : address.ip
+    ]
+  ])
+}
@@ -0,0 +1,14 @@
+data "kubernetes_network_policy_v1" "example" {
+  metadata {
+    name      = "terraform-example-network-policy"
+    namespace = "default"
+  }
+}
+
+output "allowed_egress_ports" {
+  value = flatten([
+    for rule in data.kubernetes_network_policy_v1.example.spec.0.egress : [
+      for port in rule.ports : port.port
+    ]
+  ])
+}
@@ -0,0 +1,8 @@
+data "kubernetes_nodes" "example" {
+  label_selector = "node.kubernetes.io/instance-type in (m5.large, m5.xlarge),!node-role.kubernetes.io/control-plane"
+  field_selector = "spec.unschedulable=false"
+}
+
+output "schedulable-node-names" {
+  value = [for node in data.kubernetes_nodes.example.nodes : node.metadata.0.name]
+}
@@ -0,0 +1,14 @@
+data "kubernetes_pod_logs" "example" {
+  name       = "migrate-db-x7k2p"
+  namespace  = "default"
+  container  = "migrate"
+  tail_lines = 100
+  max_bytes  = 65536
+
+  # Defer reading the logs until the job has completed.
+  trigger = kubernetes_job_v1.migrate_db.status.0.succeeded
+}
+
+output "migration_logs" {
+  value = data.kubernetes_pod_logs.example.logs
+}
@@ -0,0 +1,10 @@
+data "kubernetes_role_binding_v1" "example" {
+  metadata {
+    name      = "terraform-example"
+    namespace = "default"
+  }
+}
+
+output "subjects" {
+  value = [for s in data.kubernetes_role_binding_v1.example.subject : "${s.kind}/${s.name}"]
+}
@@ -0,0 +1,6 @@
+data "kubernetes_role_v1" "example" {
+  metadata {
+    name      = "terraform-example"
+    namespace = "default"
+  }
+}
@@ -0,0 +1,14 @@
+data "kubernetes_stateful_set_v1" "example" {
+  metadata {
+    name      = "postgres"
+    namespace = "default"
+  }
+}
+
+output "headless_service_name" {
+  value = data.kubernetes_stateful_set_v1.example.spec.0.service_name
+}
+
+output "ready_replicas" {
+  value = data.kubernetes_stateful_set_v1.example.status.0.ready_replicas
+}
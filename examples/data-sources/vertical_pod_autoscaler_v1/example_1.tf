@@ -0,0 +1,13 @@
+data "kubernetes_vertical_pod_autoscaler_v1" "example" {
+  metadata {
+    name      = "terraform-example"
+    namespace = "default"
+  }
+}
+
+output "cpu_target" {
+  value = {
+    for r in data.kubernetes_vertical_pod_autoscaler_v1.example.status.0.recommendation.0.container_recommendations :
+    r.container_name => r.target["cpu"]
+  }
+}
@@ -0,0 +1,11 @@
+provider "kubernetes" {
+  host                   = var.cluster_endpoint
+  cluster_ca_certificate = base64decode(var.cluster_ca_cert)
+  oidc {
+    idp_issuer_url = "https://dex.example.com"
+    client_id      = "kubernetes"
+    client_secret  = var.oidc_client_secret
+    refresh_token  = var.oidc_refresh_token
+    extra_scopes   = ["groups"]
+  }
+}
@@ -0,0 +1,7 @@
+resource "kubernetes_cluster_trust_bundle_v1alpha1" "example" {
+  metadata {
+    name = "example.com:mysigner:bundle"
+  }
+  signer_name  = "example.com/mysigner"
+  trust_bundle = file("${path.module}/ca.pem")
+}
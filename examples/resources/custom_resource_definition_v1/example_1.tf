@@ -0,0 +1,39 @@
+resource "kubernetes_custom_resource_definition_v1" "example" {
+  metadata {
+    name = "crontabs.stable.example.com"
+  }
+
+  spec {
+    group = "stable.example.com"
+    scope = "Namespaced"
+
+    names {
+      kind        = "CronTab"
+      plural      = "crontabs"
+      singular    = "crontab"
+      short_names = ["ct"]
+    }
+
+    version {
+      name    = "v1"
+      served  = true
+      storage = true
+
+      schema {
+        open_api_v3_schema = jsonencode({
+          type = "object"
+          properties = {
+            spec = {
+              type = "object"
+              properties = {
+                cronSpec = { type = "string" }
+                image    = { type = "string" }
+                replicas = { type = "integer" }
+              }
+            }
+          }
+        })
+      }
+    }
+  }
+}
@@ -0,0 +1,29 @@
+resource "kubernetes_flow_schema_v1beta3" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+  spec {
+    priority_level_configuration {
+      name = "workload-low"
+    }
+    matching_precedence = 1000
+    distinguisher_method {
+      type = "ByUser"
+    }
+    rule {
+      subject {
+        kind = "ServiceAccount"
+        service_account {
+          namespace = "default"
+          name      = "*"
+        }
+      }
+      resource_rule {
+        verbs      = ["get", "list", "watch"]
+        api_groups = [""]
+        resources  = ["configmaps"]
+        namespaces = ["default"]
+      }
+    }
+  }
+}
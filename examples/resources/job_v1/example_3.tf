@@ -0,0 +1,26 @@
+resource "kubernetes_job_v1" "indexed" {
+  metadata {
+    name = "indexed"
+  }
+  spec {
+    completion_mode = "Indexed"
+    completions     = 5
+    parallelism     = 2
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "worker"
+          image   = "busybox"
+          command = ["sh", "-c", "echo processing shard $JOB_COMPLETION_INDEX"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+  wait_for_completion = true
+  timeouts {
+    create = "2m"
+    update = "2m"
+  }
+}
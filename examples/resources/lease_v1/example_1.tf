@@ -0,0 +1,10 @@
+resource "kubernetes_lease_v1" "example" {
+  metadata {
+    name      = "terraform-example"
+    namespace = "kube-system"
+  }
+  spec {
+    holder_identity        = "controller-0"
+    lease_duration_seconds = 15
+  }
+}
@@ -0,0 +1,32 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "monitoring.coreos.com/v1"
+    kind       = "ServiceMonitor"
+
+    metadata = {
+      name      = "example"
+      namespace = "default"
+    }
+
+    spec = {
+      selector = {
+        matchLabels = {
+          app = "example"
+        }
+      }
+      endpoints = [
+        {
+          port     = "metrics"
+          interval = "30s"
+        }
+      ]
+    }
+  }
+
+  # validate the manifest against the API server when planning
+  dry_run = true
+}
+
+output "dry_run_result" {
+  value = jsondecode(kubernetes_manifest.test.dry_run_result)
+}
@@ -0,0 +1,40 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "apps/v1"
+    kind       = "Deployment"
+
+    metadata = {
+      name      = "example"
+      namespace = "default"
+    }
+
+    spec = {
+      selector = {
+        matchLabels = {
+          app = "example"
+        }
+      }
+      template = {
+        metadata = {
+          labels = {
+            app = "example"
+          }
+        }
+        spec = {
+          containers = [
+            {
+              name  = "example"
+              image = "nginx:1.27"
+            }
+          ]
+        }
+      }
+    }
+  }
+
+  # replicas are managed by a HorizontalPodAutoscaler
+  ignore_fields = [
+    "/spec/replicas",
+    "/metadata/annotations/deployment.kubernetes.io~1revision",
+  ]
+}
@@ -0,0 +1,20 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "stable.example.com/v1"
+    kind       = "CronTab"
+
+    metadata = {
+      name      = "example"
+      namespace = "default"
+    }
+
+    spec = {
+      cronSpec = "* * * * */5"
+      image    = "my-awesome-cron-image"
+      replicas = 3
+    }
+  }
+
+  # validate the manifest against the schema of the CronTab CRD when planning
+  validate_manifest = true
+}
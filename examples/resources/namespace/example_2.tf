@@ -0,0 +1,15 @@
+resource "kubernetes_namespace" "example" {
+  metadata {
+    name = "my-apps"
+  }
+
+  pod_security_admission_profile {
+    enforce {
+      level   = "baseline"
+      version = "v1.30"
+    }
+    warn {
+      level = "restricted"
+    }
+  }
+}
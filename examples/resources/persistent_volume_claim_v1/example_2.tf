@@ -0,0 +1,19 @@
+resource "kubernetes_persistent_volume_claim_v1" "example" {
+  metadata {
+    name = "restored-claim"
+  }
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "csi-hostpath-sc"
+    resources {
+      requests = {
+        storage = "5Gi"
+      }
+    }
+    data_source {
+      api_group = "snapshot.storage.k8s.io"
+      kind      = "VolumeSnapshot"
+      name      = "example-snapshot"
+    }
+  }
+}
@@ -0,0 +1,28 @@
+resource "kubernetes_persistent_volume_v1" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+  spec {
+    capacity = {
+      storage = "10Gi"
+    }
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "local-storage"
+    persistent_volume_source {
+      local {
+        path = "/mnt/disks/ssd1"
+      }
+    }
+    node_affinity {
+      required {
+        node_selector_term {
+          match_expressions {
+            key      = "kubernetes.io/hostname"
+            operator = "In"
+            values   = ["example-node"]
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,19 @@
+resource "kubernetes_pod" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+
+  spec {
+    container {
+      image = "nginx:1.21.6"
+      name  = "example"
+    }
+
+    ephemeral_container {
+      image                 = "busybox:1.36"
+      name                  = "debugger"
+      command               = ["sleep", "3600"]
+      target_container_name = "example"
+    }
+  }
+}
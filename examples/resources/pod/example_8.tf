@@ -0,0 +1,15 @@
+resource "kubernetes_pod" "example" {
+  metadata {
+    name = "terraform-example"
+    annotations = {
+      "container.apparmor.security.beta.kubernetes.io/example" = "localhost/k8s-apparmor-example-deny-write"
+    }
+  }
+
+  spec {
+    container {
+      image = "nginx:1.21.6"
+      name  = "example"
+    }
+  }
+}
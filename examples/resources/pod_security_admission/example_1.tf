@@ -0,0 +1,12 @@
+resource "kubernetes_pod_security_admission" "example" {
+  namespace = "my-namespace"
+  enforce   = "baseline"
+  warn      = "restricted"
+  audit     = "restricted"
+
+  psp_name = "my-psp"
+}
+
+output "suggested_level" {
+  value = kubernetes_pod_security_admission.example.migration_from_psp[0].level
+}
@@ -0,0 +1,33 @@
+resource "kubernetes_pod_template_v1" "example" {
+  metadata {
+    name = "example"
+  }
+  template {
+    metadata {
+      labels = {
+        app = "example"
+      }
+    }
+    spec {
+      container {
+        name  = "example"
+        image = "nginx:1.21.6"
+
+        port {
+          container_port = 80
+        }
+
+        resources {
+          limits = {
+            cpu    = "0.5"
+            memory = "512Mi"
+          }
+          requests = {
+            cpu    = "250m"
+            memory = "50Mi"
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "kubernetes_pod_v1" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+
+  spec {
+    container {
+      image = "nginx:1.21.6"
+      name  = "example"
+    }
+
+    scheduling_gate {
+      name = "example.com/quota"
+    }
+  }
+
+  target_state = ["Pending"]
+}
@@ -0,0 +1,42 @@
+resource "kubernetes_pod_v1" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+
+  spec {
+    container {
+      image = "nginx:1.21.6"
+      name  = "example"
+
+      volume_mount {
+        name       = "credentials"
+        mount_path = "/var/run/credentials"
+        read_only  = true
+      }
+    }
+
+    volume {
+      name = "credentials"
+
+      projected {
+        sources {
+          service_account_token {
+            audience           = "vault"
+            expiration_seconds = 3600
+            path               = "token"
+          }
+        }
+
+        sources {
+          config_map {
+            name = "vault-config"
+            items {
+              key  = "address"
+              path = "address"
+            }
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,19 @@
+resource "kubernetes_priority_level_configuration_v1beta3" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+  spec {
+    type = "Limited"
+    limited {
+      nominal_concurrency_shares = 10
+      limit_response {
+        type = "Queue"
+        queuing {
+          queues             = 64
+          hand_size          = 8
+          queue_length_limit = 50
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,41 @@
+provider "kubernetes" {
+  experiments {
+    dynamic_resource_allocation = true
+  }
+}
+
+resource "kubernetes_resource_claim_v1alpha2" "example" {
+  metadata {
+    name      = "gpu"
+    namespace = "default"
+  }
+  spec {
+    resource_class_name = "gpu.example.com"
+    allocation_mode     = "Immediate"
+  }
+}
+
+resource "kubernetes_pod_v1" "example" {
+  metadata {
+    name      = "gpu-consumer"
+    namespace = "default"
+  }
+  spec {
+    resource_claim {
+      name = "gpu"
+      source {
+        resource_claim_name = kubernetes_resource_claim_v1alpha2.example.metadata[0].name
+      }
+    }
+    container {
+      name    = "app"
+      image   = "busybox"
+      command = ["sleep", "3600"]
+      resources {
+        claims {
+          name = "gpu"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,47 @@
+resource "kubernetes_secret" "example" {
+  metadata {
+    name = "database"
+  }
+
+  data = {
+    password = var.database_password
+  }
+}
+
+resource "kubernetes_deployment" "example" {
+  metadata {
+    name = "app"
+  }
+
+  spec {
+    selector {
+      match_labels = {
+        app = "app"
+      }
+    }
+
+    template {
+      metadata {
+        labels = {
+          app = "app"
+        }
+        annotations = {
+          "checksum/secret" = sha256(jsonencode(kubernetes_secret.example.data))
+        }
+      }
+
+      spec {
+        container {
+          name  = "app"
+          image = "nginx:1.27"
+
+          env_from {
+            secret_ref {
+              name = kubernetes_secret.example.metadata[0].name
+            }
+          }
+        }
+      }
+    }
+  }
+}
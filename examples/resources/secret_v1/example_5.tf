@@ -0,0 +1,19 @@
+variable "password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "kubernetes_secret_v1" "example" {
+  metadata {
+    name = "basic-auth"
+  }
+
+  data_wo = {
+    username = "admin"
+    password = var.password
+  }
+  data_wo_revision = 1
+
+  type = "kubernetes.io/basic-auth"
+}
@@ -0,0 +1,31 @@
+resource "kubernetes_vertical_pod_autoscaler_v1" "example" {
+  metadata {
+    name = "terraform-example"
+  }
+
+  spec {
+    target_ref {
+      api_version = "apps/v1"
+      kind        = "Deployment"
+      name        = "MyApp"
+    }
+
+    update_policy {
+      update_mode = "Auto"
+    }
+
+    resource_policy {
+      container_policies {
+        container_name = "*"
+        min_allowed = {
+          cpu    = "100m"
+          memory = "64Mi"
+        }
+        max_allowed = {
+          cpu    = "1"
+          memory = "1Gi"
+        }
+      }
+    }
+  }
+}
@@ -4,6 +4,7 @@ package authenticationv1
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -81,6 +82,7 @@ func (r *TokenRequestEphemeralResource) Schema(ctx context.Context, req ephemera
 			"token": schema.StringAttribute{
 				Computed:    true,
 				Optional:    true,
+				Sensitive:   true,
 				Description: tokenreqOpenAPIStatus["token"],
 			},
 			"expiration_timestamp": schema.StringAttribute{
@@ -195,12 +197,24 @@ func (r *TokenRequestEphemeralResource) Open(ctx context.Context, req ephemeral.
 		}
 	}
 
+	requestedAt := time.Now()
 	res, err := conn.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, &tokenRequest, metav1.CreateOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError("error creating token request", err.Error())
 		return
 	}
 
+	// The API server caps the validity of service account tokens, so the
+	// issued token may expire well before the requested duration.
+	if exp := tokenRequest.Spec.ExpirationSeconds; exp != nil && !res.Status.ExpirationTimestamp.IsZero() {
+		want := time.Duration(*exp) * time.Second
+		if got := res.Status.ExpirationTimestamp.Sub(requestedAt); got < want-time.Minute {
+			resp.Diagnostics.AddWarning("Token expires earlier than requested",
+				fmt.Sprintf("Requested a token valid for %s, but the API server issued one that expires at %s. The API server caps the validity of service account tokens, so expiration_seconds should not exceed that limit.",
+					want, res.Status.ExpirationTimestamp.Format(time.RFC3339)))
+		}
+	}
+
 	data.ExpirationTimestamp = types.StringValue(res.Status.ExpirationTimestamp.Format(time.RFC3339))
 	data.Token = types.StringValue(res.Status.Token)
 
@@ -62,6 +62,8 @@ type KubernetesProviderModel struct {
 	IgnoreAnnotations types.List `tfsdk:"ignore_annotations"`
 	IgnoreLabels      types.List `tfsdk:"ignore_labels"`
 
+	ValidateReferencedSecrets types.Bool `tfsdk:"validate_referenced_secrets"`
+
 	Exec []struct {
 		APIVersion types.String            `tfsdk:"api_version"`
 		Command    types.String            `tfsdk:"command"`
@@ -69,8 +71,19 @@ type KubernetesProviderModel struct {
 		Args       []types.String          `tfsdk:"args"`
 	} `tfsdk:"exec"`
 
+	OIDC []struct {
+		IDPIssuerURL                types.String   `tfsdk:"idp_issuer_url"`
+		ClientID                    types.String   `tfsdk:"client_id"`
+		ClientSecret                types.String   `tfsdk:"client_secret"`
+		IDToken                     types.String   `tfsdk:"id_token"`
+		RefreshToken                types.String   `tfsdk:"refresh_token"`
+		IDPCertificateAuthorityData types.String   `tfsdk:"idp_certificate_authority_data"`
+		ExtraScopes                 []types.String `tfsdk:"extra_scopes"`
+	} `tfsdk:"oidc"`
+
 	Experiments []struct {
-		ManifestResource types.Bool `tfsdk:"manifest_resource"`
+		ManifestResource          types.Bool `tfsdk:"manifest_resource"`
+		DynamicResourceAllocation types.Bool `tfsdk:"dynamic_resource_allocation"`
 	} `tfsdk:"experiments"`
 }
 
@@ -153,22 +166,70 @@ func (p *KubernetesProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 				Optional:    true,
 			},
+			"validate_referenced_secrets": schema.BoolAttribute{
+				Description: "Check at plan time that secrets referenced by resources exist, e.g. the TLS secrets of `kubernetes_ingress_v1`. Requires permission to read those secrets. Secrets created later, e.g. in the same apply or by cert-manager, fail the check.",
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"exec": schema.ListNestedBlock{
+				Description: "Configuration block to use an exec-based credential plugin, e.g. call an external command to receive user credentials.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"api_version": schema.StringAttribute{
-							Required: true,
+							Description: "API version to use when decoding the ExecCredentials resource, e.g. `client.authentication.k8s.io/v1beta1`.",
+							Required:    true,
 						},
 						"command": schema.StringAttribute{
-							Required: true,
+							Description: "Command to execute.",
+							Required:    true,
 						},
 						"env": schema.MapAttribute{
+							Description: "Map of environment variables to set when executing the plugin.",
 							ElementType: types.StringType,
 							Optional:    true,
 						},
 						"args": schema.ListAttribute{
+							Description: "List of arguments to pass when executing the plugin.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"oidc": schema.ListNestedBlock{
+				Description: "Configuration block to authenticate with OpenID Connect tokens. The ID token is cached in memory and refreshed using the refresh token when it expires.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"idp_issuer_url": schema.StringAttribute{
+							Description: "URL of the OpenID Connect identity provider issuing the tokens.",
+							Required:    true,
+						},
+						"client_id": schema.StringAttribute{
+							Description: "Client ID registered with the identity provider.",
+							Required:    true,
+						},
+						"client_secret": schema.StringAttribute{
+							Description: "Client secret registered with the identity provider.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"id_token": schema.StringAttribute{
+							Description: "Initial ID token. If it is missing or expired, a new one is requested using the refresh token.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"refresh_token": schema.StringAttribute{
+							Description: "Refresh token used to request new ID tokens from the identity provider.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"idp_certificate_authority_data": schema.StringAttribute{
+							Description: "Base64 encoded PEM certificate bundle used to verify the identity provider's TLS certificate.",
+							Optional:    true,
+						},
+						"extra_scopes": schema.ListAttribute{
+							Description: "Additional scopes to request from the identity provider, besides `openid`.",
 							ElementType: types.StringType,
 							Optional:    true,
 						},
@@ -184,6 +245,10 @@ func (p *KubernetesProvider) Schema(ctx context.Context, req provider.SchemaRequ
 							Optional:           true,
 							DeprecationMessage: "The kubernetes_manifest resource is now permanently enabled and no longer considered an experiment. This flag has no effect and will be removed in the near future.",
 						},
+						"dynamic_resource_allocation": schema.BoolAttribute{
+							Description: "Enable the `resource_claim` blocks of pod specs and the `claims` blocks of container resources. These use the Dynamic Resource Allocation API, which is not stable in Kubernetes yet.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -20,14 +21,57 @@ func dataSourceKubernetesAllNamespaces() *schema.Resource {
 		Description: "This data source provides a mechanism for listing the names of all available namespaces in a Kubernetes cluster. It can be used to check for existence of a specific namespaces or to apply another resource to all or a subset of existing namespaces in a cluster.In Kubernetes, namespaces provide a scope for names and are intended as a way to divide cluster resources between multiple users.",
 		ReadContext: dataSourceKubernetesAllNamespacesRead,
 		Schema: map[string]*schema.Schema{
+			"label_selector": {
+				Type:         schema.TypeString,
+				Description:  "A selector to restrict the list of returned namespaces by their labels. Defaults to everything.",
+				Optional:     true,
+				ValidateFunc: validateLabelSelectorString,
+			},
+			"field_selector": {
+				Type:         schema.TypeString,
+				Description:  "A selector to restrict the list of returned namespaces by their fields. Defaults to everything.",
+				Optional:     true,
+				ValidateFunc: validateFieldSelectorString,
+			},
 			"namespaces": {
 				Type:        schema.TypeList,
-				Description: "List of all namespaces in a cluster.",
+				Description: "List of the names of all matching namespaces in a cluster, sorted by name.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "List of all matching namespaces in a cluster, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the namespace.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the namespace.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Description: "An unstructured key value map stored with the namespace that may be used to store arbitrary metadata.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Description: "The unique in time and space value for the namespace.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -38,22 +82,48 @@ func dataSourceKubernetesAllNamespacesRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	listOptions := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+	}
+
 	log.Printf("[INFO] Listing namespaces")
-	nsRaw, err := conn.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	nsRaw, err := conn.CoreV1().Namespaces().List(ctx, listOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
-		return diag.FromErr(err)
+		return diag.Errorf("Failed to list namespaces: %s", err)
 	}
+	sort.Slice(nsRaw.Items, func(i, j int) bool {
+		return nsRaw.Items[i].Name < nsRaw.Items[j].Name
+	})
+
 	namespaces := make([]string, len(nsRaw.Items))
+	items := make([]interface{}, len(nsRaw.Items))
 	for i, v := range nsRaw.Items {
 		namespaces[i] = v.Name
+		items[i] = map[string]interface{}{
+			"name":        v.Name,
+			"labels":      v.Labels,
+			"annotations": v.Annotations,
+			"uid":         string(v.UID),
+		}
 	}
 	log.Printf("[INFO] Received namespaces: %#v", namespaces)
 	err = d.Set("namespaces", namespaces)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("items", items)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	idsum := sha256.New()
+	for _, v := range []string{listOptions.LabelSelector, listOptions.FieldSelector} {
+		_, err := idsum.Write([]byte(v))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	for _, v := range namespaces {
 		_, err := idsum.Write([]byte(v))
 		if err != nil {
@@ -4,9 +4,11 @@
 package kubernetes
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -31,6 +33,50 @@ func TestAccKubernetesDataSourceAllNamespaces_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceAllNamespaces_selectors(t *testing.T) {
+	dataSourceName := "data.kubernetes_all_namespaces.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceAllNamespacesConfig_selectors(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "namespaces.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "namespaces.0", name),
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "items.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "items.0.labels.tf-acc-test", name),
+					resource.TestCheckResourceAttr(dataSourceName, "items.0.annotations.purpose", "selectors"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "items.0.uid", "kubernetes_namespace_v1.test", "metadata.0.uid"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDataSourceAllNamespacesConfig_basic() string {
 	return `data "kubernetes_all_namespaces" "test" {}`
 }
+
+func testAccKubernetesDataSourceAllNamespacesConfig_selectors(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace_v1" "test" {
+  metadata {
+    name = %[1]q
+    labels = {
+      tf-acc-test = %[1]q
+    }
+    annotations = {
+      purpose = "selectors"
+    }
+  }
+}
+
+data "kubernetes_all_namespaces" "test" {
+  label_selector = "tf-acc-test=${kubernetes_namespace_v1.test.metadata.0.labels.tf-acc-test}"
+  field_selector = "status.phase=Active"
+}
+`, name)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesClusterRoleBindingV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "A ClusterRoleBinding may be used to grant permission at the cluster level and in all namespaces. This data source allows you to pull data about such cluster role binding.",
+		ReadContext: dataSourceKubernetesClusterRoleBindingV1Read,
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("cluster role binding", false),
+			"role_ref": {
+				Type:        schema.TypeList,
+				Description: "RoleRef references the Cluster Role for this binding",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: rbacRoleRefSchema(),
+				},
+			},
+			"subject": {
+				Type:        schema.TypeList,
+				Description: "Subjects defines the entities to bind a ClusterRole to.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: rbacSubjectSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesClusterRoleBindingV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(metadata.Name)
+
+	log.Printf("[INFO] Reading ClusterRoleBinding %s", metadata.Name)
+	binding, err := conn.RbacV1().ClusterRoleBindings().Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read ClusterRoleBinding because: %s", err)
+	}
+	log.Printf("[INFO] Received ClusterRoleBinding: %#v", binding)
+
+	err = d.Set("metadata", flattenMetadataFields(binding.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("role_ref", flattenRBACRoleRef(binding.RoleRef))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("subject", flattenRBACSubjects(binding.Subjects))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
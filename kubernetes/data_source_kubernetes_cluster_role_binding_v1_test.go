@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceClusterRoleBindingV1_basic(t *testing.T) {
+	resourceName := "kubernetes_cluster_role_binding_v1.test"
+	dataSourceName := "data.kubernetes_cluster_role_binding_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesDataSourceClusterRoleBindingV1_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "subject.#", "2"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceClusterRoleBindingV1_basic(name) +
+					testAccKubernetesDataSourceClusterRoleBindingV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.api_group", "rbac.authorization.k8s.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.kind", "ClusterRole"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.name", "view"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.kind", "User"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.name", "notauser"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.api_group", "rbac.authorization.k8s.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.1.kind", "ServiceAccount"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.1.name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.1.namespace", "kube-system"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRoleBindingV1_bootstrap(t *testing.T) {
+	dataSourceName := "data.kubernetes_cluster_role_binding_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceClusterRoleBindingV1_nonexistent("cluster-admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", "cluster-admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.kind", "ClusterRole"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.name", "cluster-admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.kind", "Group"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.name", "system:masters"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRoleBindingV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_cluster_role_binding_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-cluster-role-binding-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceClusterRoleBindingV1_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceClusterRoleBindingV1_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role_binding_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  role_ref {
+    api_group = "rbac.authorization.k8s.io"
+    kind      = "ClusterRole"
+    name      = "view"
+  }
+
+  subject {
+    kind      = "User"
+    name      = "notauser"
+    api_group = "rbac.authorization.k8s.io"
+  }
+
+  subject {
+    kind      = "ServiceAccount"
+    name      = "default"
+    namespace = "kube-system"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceClusterRoleBindingV1_read() string {
+	return `data "kubernetes_cluster_role_binding_v1" "test" {
+  metadata {
+    name = "${kubernetes_cluster_role_binding_v1.test.metadata.0.name}"
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceClusterRoleBindingV1_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_cluster_role_binding_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+`, name)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesClusterRoleV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "A ClusterRole creates a role at the cluster level and in all namespaces. This data source allows you to pull data about such cluster role, e.g. one created when bootstrapping the cluster.",
+		ReadContext: dataSourceKubernetesClusterRoleV1Read,
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("cluster role", false),
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "List of PolicyRules for this ClusterRole",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: policyRuleSchema(),
+				},
+			},
+			"aggregation_rule": {
+				Type:        schema.TypeList,
+				Description: "Describes how to build the Rules for this ClusterRole.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: clusterRoleAggregationRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesClusterRoleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(metadata.Name)
+
+	log.Printf("[INFO] Reading cluster role %s", metadata.Name)
+	cRole, err := conn.RbacV1().ClusterRoles().Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read cluster role because: %s", err)
+	}
+	log.Printf("[INFO] Received cluster role: %#v", cRole)
+
+	err = d.Set("metadata", flattenMetadataFields(cRole.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("rule", flattenClusterRoleRules(cRole.Rules))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if cRole.AggregationRule != nil {
+		err = d.Set("aggregation_rule", flattenClusterRoleAggregationRule(cRole.AggregationRule))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
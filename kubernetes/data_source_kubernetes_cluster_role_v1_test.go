@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceClusterRoleV1_basic(t *testing.T) {
+	resourceName := "kubernetes_cluster_role_v1.test"
+	dataSourceName := "data.kubernetes_cluster_role_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesDataSourceClusterRoleV1_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceClusterRoleV1_basic(name) +
+					testAccKubernetesDataSourceClusterRoleV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.api_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.api_groups.0", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resources.0", "pods"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resources.1", "pods/log"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.0", "get"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.1", "list"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRoleV1_bootstrap(t *testing.T) {
+	dataSourceName := "data.kubernetes_cluster_role_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceClusterRoleV1_nonexistent("admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", "admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.kubernetes.io/bootstrapping", "rbac-defaults"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rule.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.0.cluster_role_selectors.0.match_labels.rbac.authorization.k8s.io/aggregate-to-admin", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRoleV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_cluster_role_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-cluster-role-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceClusterRoleV1_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceClusterRoleV1_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["pods", "pods/log"]
+    verbs      = ["get", "list"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceClusterRoleV1_read() string {
+	return `data "kubernetes_cluster_role_v1" "test" {
+  metadata {
+    name = "${kubernetes_cluster_role_v1.test.metadata.0.name}"
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceClusterRoleV1_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_cluster_role_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+`, name)
+}
@@ -27,7 +27,7 @@ func dataSourceKubernetesConfigMapV1() *schema.Resource {
 			},
 			"binary_data": {
 				Type:        schema.TypeMap,
-				Description: "A map of the config map binary data.",
+				Description: "A map of the config map binary data, with values encoded in base64 format.",
 				Computed:    true,
 			},
 			"immutable": {
@@ -41,6 +41,7 @@ func TestAccKubernetesDataSourceConfigMapV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.TestLabelOne", "one"),
 					resource.TestCheckResourceAttr(dataSourceName, "data.one", "first"),
 					resource.TestCheckResourceAttr(dataSourceName, "binary_data.raw", "UmF3IGRhdGEgc2hvdWxkIGNvbWUgYmFjayBhcyBpcyBpbiB0aGUgcG9k"),
+					resource.TestCheckResourceAttr(dataSourceName, "binary_data.bin", "AAEC/w=="),
 				),
 			},
 		},
@@ -88,6 +89,7 @@ func testAccKubernetesDataSourceConfigMapV1_basic(name string) string {
 
   binary_data = {
     raw = "${base64encode("Raw data should come back as is in the pod")}"
+    bin = "AAEC/w=="
   }
 }
 `, name)
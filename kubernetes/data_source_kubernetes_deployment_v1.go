@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesDeploymentV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Deployment provides declarative updates for pods and replica sets. This data source allows you to pull data about an existing deployment, e.g. one installed by Helm or a GitOps tool.",
+		ReadContext: dataSourceKubernetesDeploymentV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("deployment", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: deploymentSpecFields(),
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The most recently observed status of the deployment.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"observed_generation": {
+							Type:        schema.TypeInt,
+							Description: "The generation observed by the deployment controller.",
+							Computed:    true,
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of non-terminated pods targeted by this deployment.",
+							Computed:    true,
+						},
+						"updated_replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of non-terminated pods targeted by this deployment that have the desired template spec.",
+							Computed:    true,
+						},
+						"ready_replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of ready pods targeted by this deployment.",
+							Computed:    true,
+						},
+						"available_replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of available pods (ready for at least `min_ready_seconds`) targeted by this deployment.",
+							Computed:    true,
+						},
+						"unavailable_replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of unavailable pods targeted by this deployment.",
+							Computed:    true,
+						},
+						"conditions": {
+							Type:        schema.TypeList,
+							Description: "Represents the latest available observations of the deployment's current state.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Type of deployment condition, e.g. `Available` or `Progressing`.",
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: "Status of the condition, one of `True`, `False` or `Unknown`.",
+										Computed:    true,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Description: "The reason for the condition's last transition.",
+										Computed:    true,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Description: "A human readable message indicating details about the transition.",
+										Computed:    true,
+									},
+									"last_update_time": {
+										Type:        schema.TypeString,
+										Description: "The last time this condition was updated.",
+										Computed:    true,
+									},
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Description: "Last time the condition transitioned from one status to another.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesDeploymentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	om := metav1.ObjectMeta{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+	}
+	d.SetId(buildId(om))
+
+	log.Printf("[INFO] Reading deployment %s", metadata.Name)
+	deployment, err := conn.AppsV1().Deployments(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received deployment: %#v", deployment)
+
+	err = d.Set("metadata", flattenMetadataFields(deployment.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenDeploymentSpec(deployment.Spec, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", flattenDeploymentV1Status(deployment.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
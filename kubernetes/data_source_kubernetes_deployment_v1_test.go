@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceDeploymentV1_basic(t *testing.T) {
+	resourceName := "kubernetes_deployment_v1.test"
+	dataSourceName := "data.kubernetes_deployment_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := agnhostImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceDeploymentV1Config_basic(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceDeploymentV1Config_basic(name, imageName) +
+					testAccKubernetesDataSourceDeploymentV1Config_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.app", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.selector.0.match_labels.app", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.template.0.metadata.0.labels.app", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.template.0.spec.0.container.0.name", "tf-acc-test"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.template.0.spec.0.container.0.image", imageName),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.template.0.spec.0.container.0.resources.0.limits.memory", "64Mi"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.available_replicas", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status.0.conditions.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceDeploymentV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_deployment_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-deployment-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceDeploymentV1Config_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceDeploymentV1Config_basic(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%[1]s"
+    labels = {
+      app = "%[1]s"
+    }
+  }
+  spec {
+    replicas = 2
+    selector {
+      match_labels = {
+        app = "%[1]s"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          app = "%[1]s"
+        }
+      }
+      spec {
+        container {
+          image = "%[2]s"
+          name  = "tf-acc-test"
+          args  = ["test-webserver"]
+          resources {
+            limits = {
+              memory = "64Mi"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, name, imageName)
+}
+
+func testAccKubernetesDataSourceDeploymentV1Config_read() string {
+	return `data "kubernetes_deployment_v1" "test" {
+  metadata {
+    name      = kubernetes_deployment_v1.test.metadata.0.name
+    namespace = kubernetes_deployment_v1.test.metadata.0.namespace
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceDeploymentV1Config_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+`, name)
+}
@@ -5,7 +5,9 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +15,9 @@ import (
 	networking "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 )
 
 func dataSourceKubernetesIngressV1() *schema.Resource {
@@ -26,6 +31,9 @@ func dataSourceKubernetesIngressV1() *schema.Resource {
 	return &schema.Resource{
 		Description: "Ingress is a collection of rules that allow inbound connections to reach the endpoints defined by a backend. An Ingress can be configured to give services externally-reachable urls, load balance traffic, terminate SSL, offer name based virtual hosting etc. This data source allows you to pull data about such ingress.",
 		ReadContext: dataSourceKubernetesIngressV1Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("ingress", false),
 			"spec": {
@@ -112,6 +120,12 @@ func dataSourceKubernetesIngressV1() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait, within the read timeout, for the ingress controller to assign at least one ingress point in `status.load_balancer.ingress`.",
+			},
 			"status": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -172,6 +186,14 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
 
+	if len(ing.Status.LoadBalancer.Ingress) == 0 && d.Get("wait_for_load_balancer").(bool) {
+		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+		ing, err = waitForIngressV1LoadBalancer(ctx, conn, metadata.Namespace, metadata.Name, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = d.Set("metadata", flattenMetadataFields(ing.ObjectMeta))
 	if err != nil {
 		return diag.FromErr(err)
@@ -193,3 +215,29 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// waitForIngressV1LoadBalancer watches the ingress until the ingress controller
+// has assigned at least one ingress point and returns the updated ingress.
+func waitForIngressV1LoadBalancer(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) (*networking.Ingress, error) {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.NetworkingV1().Ingresses(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.NetworkingV1().Ingresses(ns).Watch(ctx, options)
+		})
+	desc := fmt.Sprintf("Ingress %s/%s", ns, name)
+	obj, err := waitForObject(ctx, lw, &networking.Ingress{}, desc, "get a load balancer", timeout, func(obj runtime.Object) (bool, string, error) {
+		if obj == nil {
+			return false, "", fmt.Errorf("%s was deleted while waiting for its load balancer", desc)
+		}
+		if len(obj.(*networking.Ingress).Status.LoadBalancer.Ingress) > 0 {
+			return true, "", nil
+		}
+		return false, "Waiting for the ingress controller to assign an IP or hostname", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*networking.Ingress), nil
+}
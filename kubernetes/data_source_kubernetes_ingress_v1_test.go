@@ -92,6 +92,29 @@ func TestAccKubernetesDataSourceIngressV1_not_found(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceIngressV1_waitForLoadBalancerGoogleCloud(t *testing.T) {
+	dataSourceName := "data.kubernetes_ingress_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+			skipIfNotRunningInGke(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceIngressV1_waitForLoadBalancer(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status.0.load_balancer.0.ingress.0.ip"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDataSourceIngressV1_basic(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_ingress_v1" "test" {
   metadata {
@@ -146,3 +169,56 @@ func testAccKubernetesDataSourceIngressV1_nonexistent(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesDataSourceIngressV1_waitForLoadBalancer(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    type = "NodePort"
+    selector = {
+      app = %[1]q
+    }
+    port {
+      port        = 8000
+      target_port = 8080
+      protocol    = "TCP"
+    }
+  }
+  lifecycle {
+    ignore_changes = [
+      metadata[0].annotations["cloud.google.com/neg"],
+      metadata[0].annotations["cloud.google.com/neg-status"],
+    ]
+  }
+}
+
+resource "kubernetes_ingress_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    default_backend {
+      service {
+        name = kubernetes_service_v1.test.metadata.0.name
+        port {
+          number = 8000
+        }
+      }
+    }
+  }
+  wait_for_load_balancer = false
+}
+
+data "kubernetes_ingress_v1" "test" {
+  metadata {
+    name = kubernetes_ingress_v1.test.metadata.0.name
+  }
+  wait_for_load_balancer = true
+  timeouts {
+    read = "45m"
+  }
+}
+`, name)
+}
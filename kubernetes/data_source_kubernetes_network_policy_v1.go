@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNetworkPolicyV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "Network policies specify how groups of pods are allowed to communicate with each other and with other network endpoints. This data source allows you to pull data about such network policy.",
+		ReadContext: dataSourceKubernetesNetworkPolicyV1Read,
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("network policy", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: networkPolicyV1SpecDoc,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: networkPolicyV1SpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesNetworkPolicyV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	om := metav1.ObjectMeta{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+	}
+	d.SetId(buildId(om))
+
+	log.Printf("[INFO] Reading network policy %s", metadata.Name)
+	np, err := conn.NetworkingV1().NetworkPolicies(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read network policy because: %s", err)
+	}
+	log.Printf("[INFO] Received network policy: %#v", np)
+
+	err = d.Set("metadata", flattenMetadataFields(np.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenNetworkPolicyV1Spec(np.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNetworkPolicyV1_basic(t *testing.T) {
+	dataSourceName := "data.kubernetes_network_policy_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Create the network policy resource in the first apply. Then check it in the second apply.
+				Config: testAccKubernetesNetworkPolicyV1Config_withEgress(name),
+			},
+			{
+				Config: testAccKubernetesNetworkPolicyV1Config_withEgress(name) +
+					testAccKubernetesDataSourceNetworkPolicyV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.pod_selector.0.match_expressions.0.key", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.pod_selector.0.match_expressions.0.values.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.ingress.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.ingress.0.ports.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.ingress.0.ports.0.port", "http"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.ingress.0.from.0.ip_block.0.cidr", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.egress.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.egress.0.ports.0.port", "statsd"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.egress.0.ports.0.protocol", "UDP"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.policy_types.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.policy_types.0", "Ingress"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.policy_types.1", "Egress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceNetworkPolicyV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_network_policy_v1.test"
+	name := fmt.Sprintf("ceci-n-est-pas-une-network-policy-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNetworkPolicyV1_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNetworkPolicyV1_read() string {
+	return `data "kubernetes_network_policy_v1" "test" {
+  metadata {
+    name      = kubernetes_network_policy_v1.test.metadata.0.name
+    namespace = kubernetes_network_policy_v1.test.metadata.0.namespace
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceNetworkPolicyV1_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_network_policy_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+}
+`, name)
+}
@@ -17,16 +17,20 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+// nodesListPageSize is the number of nodes requested per page when listing nodes.
+const nodesListPageSize = 100
+
 func dataSourceKubernetesNodes() *schema.Resource {
 	return &schema.Resource{
 		Description: "This data source provides a mechanism for listing the names of nodes in a kubernetes cluster.By default, all nodes in the cluster are returned, but queries by node label are also supported. It can be used to check for the existence of a specific node or to lookup a node to apply a taint with the `kubernetes_node_taint` resource.",
 		ReadContext: dataSourceKubernetesNodesRead,
 		Schema: map[string]*schema.Schema{
 			"metadata": {
-				Type:        schema.TypeList,
-				Description: "Metadata fields to narrow node selection.",
-				Optional:    true,
-				MaxItems:    1,
+				Type:          schema.TypeList,
+				Description:   "Metadata fields to narrow node selection.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"label_selector"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"labels": {
@@ -39,6 +43,19 @@ func dataSourceKubernetesNodes() *schema.Resource {
 					},
 				},
 			},
+			"label_selector": {
+				Type:          schema.TypeString,
+				Description:   "A selector to restrict the list of returned nodes by their labels. Defaults to everything.",
+				Optional:      true,
+				ValidateFunc:  validateLabelSelectorString,
+				ConflictsWith: []string{"metadata"},
+			},
+			"field_selector": {
+				Type:         schema.TypeString,
+				Description:  "A selector to restrict the list of returned nodes by their fields, e.g. `spec.unschedulable=false`. Defaults to everything.",
+				Optional:     true,
+				ValidateFunc: validateFieldSelectorString,
+			},
 			"nodes": {
 				Type:        schema.TypeList,
 				Description: "List of nodes in a cluster.",
@@ -74,7 +91,11 @@ func dataSourceKubernetesNodesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	listOptions := metav1.ListOptions{}
+	listOptions := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+		Limit:         nodesListPageSize,
+	}
 
 	metadata := d.Get("metadata").([]interface{})
 	if len(metadata) > 0 {
@@ -88,22 +109,28 @@ func dataSourceKubernetesNodesRead(ctx context.Context, d *schema.ResourceData,
 		listOptions.LabelSelector = labelSelector
 	}
 
-	log.Printf("[INFO] Listing nodes")
-	nodesRaw, err := conn.CoreV1().Nodes().List(ctx, listOptions)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
+	nodes := make([]interface{}, 0)
+	for {
+		log.Printf("[INFO] Listing nodes")
+		nodesRaw, err := conn.CoreV1().Nodes().List(ctx, listOptions)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return diag.FromErr(err)
 		}
-		return diag.FromErr(err)
-	}
-	nodes := make([]interface{}, len(nodesRaw.Items))
-	for i, v := range nodesRaw.Items {
-		log.Printf("[INFO] Received node: %s", v.Name)
-		nodes[i] = map[string]interface{}{
-			"metadata": flattenMetadataFields(v.ObjectMeta),
-			"spec":     flattenNodeSpec(v.Spec),
-			"status":   flattenNodeStatus(v.Status),
+		for _, v := range nodesRaw.Items {
+			log.Printf("[INFO] Received node: %s", v.Name)
+			nodes = append(nodes, map[string]interface{}{
+				"metadata": flattenMetadataFields(v.ObjectMeta),
+				"spec":     flattenNodeSpec(v.Spec),
+				"status":   flattenNodeStatus(v.Status),
+			})
+		}
+		if nodesRaw.Continue == "" {
+			break
 		}
+		listOptions.Continue = nodesRaw.Continue
 	}
 	if err := d.Set("nodes", nodes); err != nil {
 		return diag.FromErr(err)
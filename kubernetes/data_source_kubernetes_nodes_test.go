@@ -53,6 +53,10 @@ func TestAccKubernetesDataSourceNodes_basic(t *testing.T) {
 				Config: testAccKubernetesDataSourceNodes_labels(),
 				Check:  checkFuncs,
 			},
+			{
+				Config: testAccKubernetesDataSourceNodes_selectors(),
+				Check:  checkFuncs,
+			},
 		},
 	})
 }
@@ -90,6 +94,14 @@ func testAccKubernetesDataSourceNodes_labels() string {
 `
 }
 
+func testAccKubernetesDataSourceNodes_selectors() string {
+	return `data "kubernetes_nodes" "test" {
+  label_selector = "kubernetes.io/os=linux"
+  field_selector = "spec.unschedulable=false"
+}
+`
+}
+
 func testAccKubernetesDataSourceNodes_nonexistent() string {
 	return `data "kubernetes_nodes" "test" {
   metadata {
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func dataSourceKubernetesPodLogs() *schema.Resource {
+	return &schema.Resource{
+		Description: "This data source reads the logs of a container in a pod, e.g. to capture the output of a completed job for debugging or audit purposes.",
+		ReadContext: dataSourceKubernetesPodLogsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the pod to read the logs from.",
+				Required:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace of the pod.",
+				Optional:    true,
+				Default:     "default",
+			},
+			"container": {
+				Type:        schema.TypeString,
+				Description: "The container to read the logs from. Defaults to the only container if there is one container in the pod.",
+				Optional:    true,
+			},
+			"since_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "A relative time in seconds before the current time from which to show logs.",
+				Optional:     true,
+				ValidateFunc: validatePositiveInteger,
+			},
+			"tail_lines": {
+				Type:         schema.TypeInt,
+				Description:  "The number of lines from the end of the logs to show.",
+				Optional:     true,
+				ValidateFunc: validatePositiveInteger,
+			},
+			"timestamps": {
+				Type:        schema.TypeBool,
+				Description: "Prefix every line of log output with an RFC3339 timestamp.",
+				Optional:    true,
+				Default:     false,
+			},
+			"max_bytes": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of bytes of log output to store in `logs`. Longer output is truncated.",
+				Optional:     true,
+				Default:      1048576,
+				ValidateFunc: validatePositiveInteger,
+			},
+			"trigger": {
+				Type:        schema.TypeString,
+				Description: "An arbitrary value, e.g. the completion time of a job, that defers reading the logs until it is known.",
+				Optional:    true,
+			},
+			"logs": {
+				Type:        schema.TypeString,
+				Description: "The log output of the container.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesPodLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	namespace := d.Get("namespace").(string)
+	maxBytes := int64(d.Get("max_bytes").(int))
+
+	opts := &corev1.PodLogOptions{
+		Container:  d.Get("container").(string),
+		Timestamps: d.Get("timestamps").(bool),
+		LimitBytes: ptr.To(maxBytes),
+	}
+	if v, ok := d.GetOk("since_seconds"); ok {
+		opts.SinceSeconds = ptr.To(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("tail_lines"); ok {
+		opts.TailLines = ptr.To(int64(v.(int)))
+	}
+
+	log.Printf("[INFO] Reading logs of pod %s/%s", namespace, name)
+	logs, err := conn.CoreV1().Pods(namespace).GetLogs(name, opts).DoRaw(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read logs of pod %s/%s: %s", namespace, name, err)
+	}
+	logs = truncatePodLogs(logs, maxBytes)
+
+	id := fmt.Sprintf("%s/%s", namespace, name)
+	if opts.Container != "" {
+		id = fmt.Sprintf("%s/%s", id, opts.Container)
+	}
+	d.SetId(id)
+
+	err = d.Set("logs", string(logs))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// truncatePodLogs cuts logs down to at most maxBytes, as the API server treats
+// limitBytes as a hint and may return slightly more. It steps back to a rune
+// boundary so that a multi-byte UTF-8 character is not split.
+func truncatePodLogs(logs []byte, maxBytes int64) []byte {
+	if int64(len(logs)) <= maxBytes {
+		return logs
+	}
+	end := int(maxBytes)
+	for end > 0 && !utf8.RuneStart(logs[end]) {
+		end--
+	}
+	return logs[:end]
+}
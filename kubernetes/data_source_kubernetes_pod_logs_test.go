@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourcePodLogs_basic(t *testing.T) {
+	dataSourceName := "data.kubernetes_pod_logs.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodLogsConfig_pod(name, imageName),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodLogsConfig_pod(name, imageName) +
+					testAccKubernetesDataSourcePodLogsConfig_read(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs", "first line\nsecond line\n"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodLogsConfig_pod(name, imageName) +
+					testAccKubernetesDataSourcePodLogsConfig_read(5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "max_bytes", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs", "first"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodLogsConfig_pod(name, imageName) +
+					testAccKubernetesDataSourcePodLogsConfig_tail(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "logs", "second line\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourcePodLogs_not_found(t *testing.T) {
+	name := fmt.Sprintf("ceci-n.est-pas-une-pod-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourcePodLogsConfig_nonexistent(name),
+				ExpectError: regexp.MustCompile("Failed to read logs of pod"),
+			},
+		},
+	})
+}
+
+func TestTruncatePodLogs(t *testing.T) {
+	cases := map[string]struct {
+		logs     string
+		maxBytes int64
+		expected string
+	}{
+		"shorter":          {logs: "hello", maxBytes: 10, expected: "hello"},
+		"exact":            {logs: "hello", maxBytes: 5, expected: "hello"},
+		"ascii":            {logs: "hello world", maxBytes: 5, expected: "hello"},
+		"rune boundary":    {logs: "héllo", maxBytes: 3, expected: "hé"},
+		"split two bytes":  {logs: "héllo", maxBytes: 2, expected: "h"},
+		"split four bytes": {logs: "a😀b", maxBytes: 4, expected: "a"},
+		"split first rune": {logs: "€", maxBytes: 2, expected: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := string(truncatePodLogs([]byte(tc.logs), tc.maxBytes))
+			if got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func testAccKubernetesDataSourcePodLogsConfig_pod(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sh", "-c", "echo first line; echo second line; sleep 3600"]
+    }
+  }
+}
+`, name, imageName)
+}
+
+func testAccKubernetesDataSourcePodLogsConfig_read(maxBytes int) string {
+	limit := ""
+	if maxBytes > 0 {
+		limit = fmt.Sprintf("max_bytes = %d", maxBytes)
+	}
+	return fmt.Sprintf(`data "kubernetes_pod_logs" "test" {
+  name      = kubernetes_pod_v1.test.metadata.0.name
+  namespace = kubernetes_pod_v1.test.metadata.0.namespace
+  container = "containername"
+  %s
+}
+`, limit)
+}
+
+func testAccKubernetesDataSourcePodLogsConfig_tail() string {
+	return `data "kubernetes_pod_logs" "test" {
+  name       = kubernetes_pod_v1.test.metadata.0.name
+  tail_lines = 1
+}
+`
+}
+
+func testAccKubernetesDataSourcePodLogsConfig_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_pod_logs" "test" {
+  name = "%s"
+}
+`, name)
+}
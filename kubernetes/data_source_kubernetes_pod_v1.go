@@ -6,9 +6,11 @@ package kubernetes
 import (
 	"context"
 	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -32,9 +34,94 @@ func dataSourceKubernetesPodV1() *schema.Resource {
 					Schema: podSpecFields,
 				},
 			},
+			"label_selector": {
+				Type:         schema.TypeString,
+				Description:  "A selector to find the pod by its labels when its name is not known, e.g. `app=nginx`. The first matching pod in the namespace, ordered by name, is read.",
+				Optional:     true,
+				ValidateFunc: validateLabelSelectorString,
+			},
+			"field_selector": {
+				Type:         schema.TypeString,
+				Description:  "A selector to find the pod by its fields when its name is not known, e.g. `spec.nodeName=worker-1` or `status.phase=Running`. Can be combined with `label_selector`.",
+				Optional:     true,
+				ValidateFunc: validateFieldSelectorString,
+			},
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "The phase of the pod, e.g. `Pending`, `Running`, `Succeeded`, `Failed` or `Unknown`.",
+				Computed:    true,
+			},
+			"pod_ip": {
+				Type:        schema.TypeString,
+				Description: "IP address allocated to the pod. Empty if not yet allocated.",
+				Computed:    true,
+			},
+			"host_ip": {
+				Type:        schema.TypeString,
+				Description: "IP address of the host to which the pod is assigned. Empty if not yet scheduled.",
+				Computed:    true,
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Description: "Current service state of the pod.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"container_statuses": {
+				Type:        schema.TypeList,
+				Description: "The statuses of the containers of the pod, one per container.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ready": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"started": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restart_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -48,21 +135,43 @@ func dataSourceKubernetesPodV1Read(ctx context.Context, d *schema.ResourceData,
 
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 
-	om := metav1.ObjectMeta{
-		Namespace: metadata.Namespace,
-		Name:      metadata.Name,
-	}
-	d.SetId(buildId(om))
-
-	log.Printf("[INFO] Reading pod %s", metadata.Name)
-	pod, err := conn.CoreV1().Pods(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
-	if err != nil {
-		if apierrors.IsNotFound(err) {
+	var pod *api.Pod
+	if metadata.Name == "" {
+		listOptions := metav1.ListOptions{
+			LabelSelector: d.Get("label_selector").(string),
+			FieldSelector: d.Get("field_selector").(string),
+		}
+		if listOptions.LabelSelector == "" && listOptions.FieldSelector == "" {
+			return diag.Errorf("One of metadata.0.name, label_selector or field_selector must be set")
+		}
+		log.Printf("[INFO] Listing pods in %s with %#v", metadata.Namespace, listOptions)
+		pods, err := conn.CoreV1().Pods(metadata.Namespace).List(ctx, listOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.FromErr(err)
+		}
+		if len(pods.Items) == 0 {
+			log.Printf("[INFO] No pod in %s matches %#v", metadata.Namespace, listOptions)
+			d.SetId(metadata.Namespace + "/")
 			return nil
 		}
-		log.Printf("[DEBUG] Received error: %#v", err)
-		return diag.FromErr(err)
+		sort.Slice(pods.Items, func(i, j int) bool {
+			return pods.Items[i].Name < pods.Items[j].Name
+		})
+		pod = &pods.Items[0]
+	} else {
+		log.Printf("[INFO] Reading pod %s", metadata.Name)
+		pod, err = conn.CoreV1().Pods(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				d.SetId(buildId(metadata))
+				return nil
+			}
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.FromErr(err)
+		}
 	}
+	d.SetId(buildId(pod.ObjectMeta))
 	log.Printf("[INFO] Received pod: %#v", pod)
 
 	err = d.Set("metadata", flattenMetadataFields(pod.ObjectMeta))
@@ -83,6 +192,22 @@ func dataSourceKubernetesPodV1Read(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("pod_ip", pod.Status.PodIP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("host_ip", pod.Status.HostIP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("conditions", flattenPodConditions(pod.Status.Conditions))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("container_statuses", flattenContainerStatuses(pod.Status.ContainerStatuses))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 
@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -34,6 +35,38 @@ func TestAccKubernetesDataSourcePodV1_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
 					resource.TestCheckResourceAttr(dataSourceName, "spec.0.container.0.image", imageName),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Running"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pod_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "host_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "conditions.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_statuses.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_statuses.0.name", "containername"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_statuses.0.ready", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourcePodV1_labelSelector(t *testing.T) {
+	dataSourceName := "data.kubernetes_pod_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodV1_labelSelector(name, imageName),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodV1_labelSelector(name, imageName) +
+					testAccKubernetesDataSourcePodV1_readByLabelSelector(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.app", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.container.0.image", imageName),
 				),
 			},
 		},
@@ -59,6 +92,34 @@ func TestAccKubernetesDataSourcePodV1_not_found(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourcePodV1_fieldSelector(t *testing.T) {
+	dataSourceName := "data.kubernetes_pod_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourcePodV1_readByFieldSelector("status.phase"),
+				ExpectError: regexp.MustCompile("is not a valid field selector"),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodV1_labelSelector(name, imageName),
+			},
+			{
+				Config: testAccKubernetesDataSourcePodV1_labelSelector(name, imageName) +
+					testAccKubernetesDataSourcePodV1_readByFieldSelector("status.phase=Running,metadata.name=${kubernetes_pod_v1.test.metadata.0.name}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Running"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDataSourcePodV1_basic(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
   metadata {
@@ -91,3 +152,41 @@ func testAccKubernetesDataSourcePodV1_nonexistent(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesDataSourcePodV1_labelSelector(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      app = "%s"
+    }
+  }
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, name, name, imageName)
+}
+
+func testAccKubernetesDataSourcePodV1_readByLabelSelector() string {
+	return `data "kubernetes_pod_v1" "test" {
+  metadata {
+    namespace = kubernetes_pod_v1.test.metadata.0.namespace
+  }
+  label_selector = "app=${kubernetes_pod_v1.test.metadata.0.labels.app}"
+}
+`
+}
+
+func testAccKubernetesDataSourcePodV1_readByFieldSelector(selector string) string {
+	return fmt.Sprintf(`data "kubernetes_pod_v1" "test" {
+  metadata {
+    namespace = "default"
+  }
+  field_selector = "%s"
+}
+`, selector)
+}
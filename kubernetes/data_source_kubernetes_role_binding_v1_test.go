@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceRoleBindingV1_basic(t *testing.T) {
+	resourceName := "kubernetes_role_binding_v1.test"
+	dataSourceName := "data.kubernetes_role_binding_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesDataSourceRoleBindingV1_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "subject.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceRoleBindingV1_basic(name) +
+					testAccKubernetesDataSourceRoleBindingV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.api_group", "rbac.authorization.k8s.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.kind", "ClusterRole"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.0.name", "view"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.kind", "ServiceAccount"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.0.namespace", "default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceRoleBindingV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_role_binding_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-role-binding-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceRoleBindingV1_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ref.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "subject.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceRoleBindingV1_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_role_binding_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+
+  role_ref {
+    api_group = "rbac.authorization.k8s.io"
+    kind      = "ClusterRole"
+    name      = "view"
+  }
+
+  subject {
+    kind      = "ServiceAccount"
+    name      = "default"
+    namespace = "default"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceRoleBindingV1_read() string {
+	return `data "kubernetes_role_binding_v1" "test" {
+  metadata {
+    name      = "${kubernetes_role_binding_v1.test.metadata.0.name}"
+    namespace = "${kubernetes_role_binding_v1.test.metadata.0.namespace}"
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceRoleBindingV1_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_role_binding_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+}
+`, name)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesRoleV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "A role contains rules that represent a set of permissions. This data source allows you to pull data about such role, e.g. one managed by a Helm chart.",
+		ReadContext: dataSourceKubernetesRoleV1Read,
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("role", false),
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Rule defining a set of permissions for the role",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: roleRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesRoleV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	om := metav1.ObjectMeta{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+	}
+	d.SetId(buildId(om))
+
+	log.Printf("[INFO] Reading role %s", metadata.Name)
+	role, err := conn.RbacV1().Roles(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read role because: %s", err)
+	}
+	log.Printf("[INFO] Received role: %#v", role)
+
+	err = d.Set("metadata", flattenMetadataFields(role.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("rule", flattenRules(&role.Rules))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
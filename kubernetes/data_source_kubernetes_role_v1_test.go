@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceRoleV1_basic(t *testing.T) {
+	resourceName := "kubernetes_role_v1.test"
+	dataSourceName := "data.kubernetes_role_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesDataSourceRoleV1_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceRoleV1_basic(name) +
+					testAccKubernetesDataSourceRoleV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.api_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.api_groups.*", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.resources.*", "pods"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resource_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.resource_names.*", "foo"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.verbs.*", "get"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.verbs.*", "list"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceRoleV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_role_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-role-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceRoleV1_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceRoleV1_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_role_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+
+  rule {
+    api_groups     = [""]
+    resources      = ["pods"]
+    resource_names = ["foo"]
+    verbs          = ["get", "list"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceRoleV1_read() string {
+	return `data "kubernetes_role_v1" "test" {
+  metadata {
+    name      = "${kubernetes_role_v1.test.metadata.0.name}"
+    namespace = "${kubernetes_role_v1.test.metadata.0.namespace}"
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceRoleV1_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_role_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+}
+`, name)
+}
@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"log"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,8 +29,9 @@ func dataSourceKubernetesSecretV1() *schema.Resource {
 			},
 			"binary_data": {
 				Type:        schema.TypeMap,
-				Description: "A map of the secret data with values encoded in base64 format",
+				Description: "A map of the secret data with values encoded in base64 format. Values that are not valid UTF-8 are always returned here instead of in `data`.",
 				Optional:    true,
+				Computed:    true,
 				Sensitive:   true,
 			},
 			"type": {
@@ -75,17 +77,22 @@ func dataSourceKubernetesSecretV1Read(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	binaryDataKeys := []string{}
+	binaryData := map[string][]byte{}
 	if v, ok := d.GetOk("binary_data"); ok {
-		binaryData := map[string][]byte{}
 		for k := range v.(map[string]interface{}) {
 			binaryData[k] = secret.Data[k]
-			binaryDataKeys = append(binaryDataKeys, k)
 		}
-		d.Set("binary_data", base64EncodeByteMap(binaryData))
 	}
+	// Values that are not valid UTF-8 cannot be represented in `data`
+	// without being corrupted, return them encoded instead.
+	for k, v := range secret.Data {
+		if !utf8.Valid(v) {
+			binaryData[k] = v
+		}
+	}
+	d.Set("binary_data", base64EncodeByteMap(binaryData))
 
-	for _, k := range binaryDataKeys {
+	for k := range binaryData {
 		delete(secret.Data, k)
 	}
 	d.Set("data", flattenByteMapToStringMap(secret.Data))
@@ -81,6 +81,32 @@ func TestAccKubernetesDataSourceSecretV1_generateName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceSecretV1_binaryData(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_secret_v1.test"
+	datasourceName := "data.kubernetes_secret_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceSecretV1_binaryData(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceSecretV1_binaryData(name) +
+					testAccKubernetesDataSourceSecretV1_readBinaryData(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "data.%", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "data.text", resourceName, "data.text"),
+					resource.TestCheckResourceAttr(datasourceName, "binary_data.%", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "binary_data.raw", resourceName, "binary_data.raw"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDataSourceSecretV1_not_found(t *testing.T) {
 	name := fmt.Sprintf("ceci-n.est-pas-une-secret-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	datasourceName := "data.kubernetes_secret_v1.test"
@@ -167,6 +193,30 @@ func testAccKubernetesDataSourceSecretV1_read() string {
 `
 }
 
+func testAccKubernetesDataSourceSecretV1_binaryData(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_secret_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  data = {
+    text = "UTF-8 data is returned as is"
+  }
+  binary_data = {
+    raw = "//79/A=="
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceSecretV1_readBinaryData() string {
+	return `data "kubernetes_secret_v1" "test" {
+  metadata {
+    name = kubernetes_secret_v1.test.metadata.0.name
+  }
+}
+`
+}
+
 func testAccKubernetesDataSourceSecretV1_nonexistent(name string) string {
 	return fmt.Sprintf(`data "kubernetes_secret_v1" "test" {
   metadata {
@@ -5,7 +5,9 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,12 +15,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 )
 
 func dataSourceKubernetesServiceV1() *schema.Resource {
 	return &schema.Resource{
 		Description: "A Service is an abstraction which defines a logical set of pods and a policy by which to access them - sometimes called a micro-service. This data source allows you to pull data about such service.",
 		ReadContext: dataSourceKubernetesServiceV1Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("service", false),
 			"spec": {
@@ -206,6 +214,12 @@ func dataSourceKubernetesServiceV1() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait, within the read timeout, for a service of type `LoadBalancer` to have at least one ingress point assigned in `status.load_balancer.ingress`.",
+			},
 			"status": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -267,6 +281,18 @@ func dataSourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceDa
 	}
 	log.Printf("[INFO] Received service: %#v", svc)
 
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer && len(svc.Status.LoadBalancer.Ingress) == 0 && d.Get("wait_for_load_balancer").(bool) {
+		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+		svc, err = waitForServiceV1LoadBalancer(ctx, conn, metadata.Namespace, metadata.Name, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, om, "Service", 3)
+			if wErr != nil {
+				return diag.FromErr(wErr)
+			}
+			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		}
+	}
+
 	err = d.Set("metadata", flattenMetadataFields(svc.ObjectMeta))
 	if err != nil {
 		return diag.FromErr(err)
@@ -288,3 +314,29 @@ func dataSourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// waitForServiceV1LoadBalancer watches the service until the load balancer
+// has assigned at least one ingress point and returns the updated service.
+func waitForServiceV1LoadBalancer(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) (*corev1.Service, error) {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.CoreV1().Services(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.CoreV1().Services(ns).Watch(ctx, options)
+		})
+	desc := fmt.Sprintf("Service %s/%s", ns, name)
+	obj, err := waitForObject(ctx, lw, &corev1.Service{}, desc, "get a load balancer", timeout, func(obj runtime.Object) (bool, string, error) {
+		if obj == nil {
+			return false, "", fmt.Errorf("%s was deleted while waiting for its load balancer", desc)
+		}
+		if len(obj.(*corev1.Service).Status.LoadBalancer.Ingress) > 0 {
+			return true, "", nil
+		}
+		return false, "Waiting for the load balancer to assign an IP or hostname", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.Service), nil
+}
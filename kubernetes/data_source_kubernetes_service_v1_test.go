@@ -103,6 +103,31 @@ func TestAccKubernetesDataSourceServiceV1_not_found(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceServiceV1_waitForLoadBalancer(t *testing.T) {
+	dataSourceName := "data.kubernetes_service_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoLoadBalancersAvailable(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServiceV1_loadBalancer(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceServiceV1_loadBalancer(name) +
+					testAccKubernetesDataSourceServiceV1_readWaitForLoadBalancer(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.type", "LoadBalancer"),
+					resource.TestCheckResourceAttr(dataSourceName, "wait_for_load_balancer", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.load_balancer.0.ingress.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDataSourceServiceV1_basic(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_service_v1" "test" {
   metadata {
@@ -147,3 +172,30 @@ func testAccKubernetesDataSourceServiceV1_nonexistent(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesDataSourceServiceV1_loadBalancer(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    type = "LoadBalancer"
+    port {
+      port        = 8080
+      target_port = 80
+    }
+  }
+  wait_for_load_balancer = false
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceServiceV1_readWaitForLoadBalancer() string {
+	return `data "kubernetes_service_v1" "test" {
+  metadata {
+    name = kubernetes_service_v1.test.metadata.0.name
+  }
+  wait_for_load_balancer = true
+}
+`
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesStatefulSetV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "StatefulSet is the workload API object used to manage stateful applications. This data source allows you to pull data about an existing stateful set.",
+		ReadContext: dataSourceKubernetesStatefulSetV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("stateful set", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired identities of pods in this set.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: statefulSetSpecFields(),
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The most recently observed status of the stateful set.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"observed_generation": {
+							Type:        schema.TypeInt,
+							Description: "The most recent generation observed for this stateful set.",
+							Computed:    true,
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Description: "The number of pods created by the stateful set controller.",
+							Computed:    true,
+						},
+						"ready_replicas": {
+							Type:        schema.TypeInt,
+							Description: "The number of pods created for this stateful set with a Ready condition.",
+							Computed:    true,
+						},
+						"current_replicas": {
+							Type:        schema.TypeInt,
+							Description: "The number of pods created by the stateful set controller from the stateful set version indicated by `current_revision`.",
+							Computed:    true,
+						},
+						"updated_replicas": {
+							Type:        schema.TypeInt,
+							Description: "The number of pods created by the stateful set controller from the stateful set version indicated by `update_revision`.",
+							Computed:    true,
+						},
+						"available_replicas": {
+							Type:        schema.TypeInt,
+							Description: "Total number of available pods (ready for at least `min_ready_seconds`) targeted by this stateful set.",
+							Computed:    true,
+						},
+						"current_revision": {
+							Type:        schema.TypeString,
+							Description: "The version of the stateful set used to generate pods in the sequence `[0,current_replicas)`.",
+							Computed:    true,
+						},
+						"update_revision": {
+							Type:        schema.TypeString,
+							Description: "The version of the stateful set used to generate pods in the sequence `[replicas-updated_replicas,replicas)`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesStatefulSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	om := metav1.ObjectMeta{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+	}
+	d.SetId(buildId(om))
+
+	log.Printf("[INFO] Reading stateful set %s", metadata.Name)
+	statefulSet, err := conn.AppsV1().StatefulSets(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received stateful set: %#v", statefulSet)
+
+	err = d.Set("metadata", flattenMetadataFields(statefulSet.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenStatefulSetSpec(statefulSet.Spec, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Unlike the resource, there is no configuration to cause a perpetual
+	// diff, so the update strategy is always exposed.
+	spec[0].(map[string]interface{})["update_strategy"] = flattenStatefulSetSpecUpdateStrategy(statefulSet.Spec.UpdateStrategy)
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", flattenStatefulSetV1Status(statefulSet.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
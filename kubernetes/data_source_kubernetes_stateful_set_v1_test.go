@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceStatefulSetV1_basic(t *testing.T) {
+	resourceName := "kubernetes_stateful_set_v1.test"
+	dataSourceName := "data.kubernetes_stateful_set_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceStatefulSetV1Config_basic(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourceStatefulSetV1Config_basic(name, imageName) +
+					testAccKubernetesDataSourceStatefulSetV1Config_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.replicas", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.service_name", "ss-test-service"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.selector.0.match_labels.app", "ss-test"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.template.0.spec.0.container.0.image", imageName),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.update_strategy.0.type", "RollingUpdate"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.volume_claim_template.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.volume_claim_template.0.metadata.0.name", "ss-test"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.volume_claim_template.0.spec.0.resources.0.requests.storage", "1Gi"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.current_replicas", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.ready_replicas", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceStatefulSetV1_not_found(t *testing.T) {
+	dataSourceName := "data.kubernetes_stateful_set_v1.test"
+	name := fmt.Sprintf("ceci-n.est-pas-une-stateful-set-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceStatefulSetV1Config_nonexistent(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceStatefulSetV1Config_basic(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_stateful_set_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels = {
+        app = "ss-test"
+      }
+    }
+    service_name = "ss-test-service"
+    template {
+      metadata {
+        labels = {
+          app = "ss-test"
+        }
+      }
+      spec {
+        container {
+          name    = "ss-test"
+          image   = "%s"
+          command = ["sleep", "300"]
+          volume_mount {
+            name       = "ss-test"
+            mount_path = "/data"
+          }
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+    volume_claim_template {
+      metadata {
+        name = "ss-test"
+      }
+      spec {
+        access_modes = ["ReadWriteOnce"]
+        resources {
+          requests = {
+            storage = "1Gi"
+          }
+        }
+      }
+    }
+  }
+}
+`, name, imageName)
+}
+
+func testAccKubernetesDataSourceStatefulSetV1Config_read() string {
+	return `data "kubernetes_stateful_set_v1" "test" {
+  metadata {
+    name      = kubernetes_stateful_set_v1.test.metadata.0.name
+    namespace = kubernetes_stateful_set_v1.test.metadata.0.namespace
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceStatefulSetV1Config_nonexistent(name string) string {
+	return fmt.Sprintf(`data "kubernetes_stateful_set_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+`, name)
+}
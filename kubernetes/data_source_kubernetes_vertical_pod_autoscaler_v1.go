@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesVerticalPodAutoscalerV1() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the resource recommendations of a vertical pod autoscaler, e.g. one running with update mode `Off` to support capacity planning.",
+		ReadContext: dataSourceKubernetesVerticalPodAutoscalerV1Read,
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("vertical pod autoscaler", false),
+			"status":   verticalPodAutoscalerV1StatusSchema(),
+		},
+	}
+}
+
+func dataSourceKubernetesVerticalPodAutoscalerV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	om := metav1.ObjectMeta{
+		Namespace: metadata.Namespace,
+		Name:      metadata.Name,
+	}
+	d.SetId(buildId(om))
+
+	// Clusters without the autoscaler have no recommendations to offer, which
+	// should not break configurations that are shared across clusters.
+	if verr := checkVerticalPodAutoscalerV1Available(dc); verr != nil {
+		err = d.Set("status", flattenVerticalPodAutoscalerV1Status(nil))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Vertical pod autoscaler is not installed",
+			Detail:   verr.Error(),
+		}}
+	}
+
+	log.Printf("[INFO] Reading vertical pod autoscaler %s", metadata.Name)
+	out, err := conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read vertical pod autoscaler because: %s", err)
+	}
+	vpa, err := verticalPodAutoscalerV1FromUnstructured(out)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received vertical pod autoscaler: %#v", vpa)
+
+	err = d.Set("metadata", flattenMetadataFields(vpa.ObjectMeta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", flattenVerticalPodAutoscalerV1Status(vpa.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceVerticalPodAutoscalerV1_basic(t *testing.T) {
+	dataSourceName := "data.kubernetes_vertical_pod_autoscaler_v1.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoVerticalPodAutoscalerV1(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using a data source.
+				Config: testAccKubernetesVerticalPodAutoscalerV1Config_basic(name, "Off", "100m"),
+			},
+			{
+				Config: testAccKubernetesVerticalPodAutoscalerV1Config_basic(name, "Off", "100m") +
+					testAccKubernetesDataSourceVerticalPodAutoscalerV1_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.recommendation.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceVerticalPodAutoscalerV1_read() string {
+	return `data "kubernetes_vertical_pod_autoscaler_v1" "test" {
+  metadata {
+    name      = kubernetes_vertical_pod_autoscaler_v1.test.metadata.0.name
+    namespace = kubernetes_vertical_pod_autoscaler_v1.test.metadata.0.namespace
+  }
+}
+`
+}
@@ -4,6 +4,9 @@
 package kubernetes
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -19,3 +22,63 @@ func suppressEquivalentResourceQuantity(k, old, new string, d *schema.ResourceDa
 	}
 	return oldQ.Cmp(newQ) == 0
 }
+
+// caBundleInjectionAnnotations are the annotations that instruct a controller,
+// such as the cert-manager CA injector, to populate the webhook CA bundle.
+var caBundleInjectionAnnotations = []string{
+	"cert-manager.io/inject-ca-from",
+	"cert-manager.io/inject-ca-from-secret",
+	"cert-manager.io/inject-apiserver-ca",
+}
+
+// suppressInjectedCABundle suppresses the diff on a webhook CA bundle that is
+// managed outside of Terraform: either it was left empty in the configuration,
+// or the object carries an annotation asking a controller to inject it.
+func suppressInjectedCABundle(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" && old != "" {
+		return true
+	}
+	annotations, ok := d.Get("metadata.0.annotations").(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, a := range caBundleInjectionAnnotations {
+		if _, ok := annotations[a]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// suppressControllerAdvancedTime suppresses the diff on an RFC 3339 timestamp
+// that a controller keeps moving forward, such as a lease renew time, unless
+// the configuration sets it to a later point in time than the live value.
+func suppressControllerAdvancedTime(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return true
+	}
+	oldT, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newT, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return !newT.After(oldT)
+}
+
+// suppressControllerAdvancedCounter suppresses the diff on a counter that a
+// controller keeps incrementing, such as lease transitions, unless the
+// configuration sets it to a higher value than the live one.
+func suppressControllerAdvancedCounter(k, old, new string, d *schema.ResourceData) bool {
+	oldV, err := strconv.Atoi(old)
+	if err != nil {
+		return false
+	}
+	newV, err := strconv.Atoi(new)
+	if err != nil {
+		return false
+	}
+	return newV <= oldV
+}
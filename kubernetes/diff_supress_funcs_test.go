@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSuppressInjectedCABundle(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]interface{}
+		old         string
+		new         string
+		expected    bool
+	}{
+		"unchanged": {
+			old:      "",
+			new:      "",
+			expected: false,
+		},
+		"left empty": {
+			old:      "injected",
+			new:      "",
+			expected: true,
+		},
+		"set by user": {
+			old:      "old",
+			new:      "new",
+			expected: false,
+		},
+		"injected by cert-manager": {
+			annotations: map[string]interface{}{
+				"cert-manager.io/inject-ca-from": "default/webhook-cert",
+			},
+			old:      "injected",
+			new:      "placeholder",
+			expected: true,
+		},
+	}
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			rawData := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{
+					"annotations": c.annotations,
+				}},
+			}
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"metadata": metadataSchema("fake", true)}, rawData)
+			if out := suppressInjectedCABundle("ca_bundle", c.old, c.new, d); out != c.expected {
+				t.Fatalf("Expected %t, got %t", c.expected, out)
+			}
+		})
+	}
+}
+
+func TestSuppressControllerAdvancedTime(t *testing.T) {
+	cases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"not configured": {
+			old:      "2024-01-01T00:00:10.000000Z",
+			new:      "",
+			expected: true,
+		},
+		"same instant": {
+			old:      "2024-01-01T00:00:00.000000Z",
+			new:      "2024-01-01T00:00:00Z",
+			expected: true,
+		},
+		"renewed by controller": {
+			old:      "2024-01-01T00:05:00.000000Z",
+			new:      "2024-01-01T00:00:00Z",
+			expected: true,
+		},
+		"moved forward by user": {
+			old:      "2024-01-01T00:05:00.000000Z",
+			new:      "2024-01-02T00:00:00Z",
+			expected: false,
+		},
+		"unset in cluster": {
+			old:      "",
+			new:      "2024-01-02T00:00:00Z",
+			expected: false,
+		},
+	}
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := suppressControllerAdvancedTime("spec.0.renew_time", c.old, c.new, nil); got != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestSuppressControllerAdvancedCounter(t *testing.T) {
+	cases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"unchanged": {
+			old:      "3",
+			new:      "3",
+			expected: true,
+		},
+		"incremented by controller": {
+			old:      "5",
+			new:      "3",
+			expected: true,
+		},
+		"raised by user": {
+			old:      "3",
+			new:      "10",
+			expected: false,
+		},
+	}
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := suppressControllerAdvancedCounter("spec.0.lease_transitions", c.old, c.new, nil); got != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// lifecycleHook is a command run in a container of a pod of a resource.
+type lifecycleHook struct {
+	Command   []string
+	Container string
+	Timeout   time.Duration
+}
+
+// podFinderFunc returns the name of the pod lifecycle hooks are run in.
+type podFinderFunc func(ctx context.Context) (string, error)
+
+// errPodsFinished is returned by a podFinderFunc when the pods of a resource
+// have finished, so no pod will ever run the hooks.
+var errPodsFinished = errors.New("all pods have finished")
+
+// expandLifecycleHooks returns the hooks of the lifecycle_hook blocks in l that
+// are run on the given event.
+func expandLifecycleHooks(l []interface{}, event string) []lifecycleHook {
+	hooks := make([]lifecycleHook, 0, len(l))
+	for _, h := range l {
+		if h == nil {
+			continue
+		}
+		in := h.(map[string]interface{})
+		if in["on_event"].(string) != event {
+			continue
+		}
+		hooks = append(hooks, lifecycleHook{
+			Command:   expandStringSlice(in["command"].([]interface{})),
+			Container: in["container"].(string),
+			Timeout:   time.Duration(in["timeout_seconds"].(int)) * time.Second,
+		})
+	}
+	return hooks
+}
+
+// podByName returns a podFinderFunc that always returns the given pod.
+func podByName(name string) podFinderFunc {
+	return func(ctx context.Context) (string, error) {
+		return name, nil
+	}
+}
+
+// runningPodBySelector returns a podFinderFunc that waits for a running pod
+// matched by selector and returns its name. If finished is not nil, it is
+// called while no pod is running, and errPodsFinished is returned once it
+// reports that the pods have finished.
+func runningPodBySelector(conn *kubernetes.Clientset, namespace string, selector *metav1.LabelSelector, finished func(ctx context.Context) (bool, error)) podFinderFunc {
+	return func(ctx context.Context) (string, error) {
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return "", err
+		}
+		var name string
+		err = wait.PollUntilContextCancel(ctx, 2*time.Second, true, func(ctx context.Context) (bool, error) {
+			pods, err := conn.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: s.String()})
+			if err != nil {
+				return false, err
+			}
+			for _, p := range pods.Items {
+				if p.Status.Phase == corev1.PodRunning && p.DeletionTimestamp == nil {
+					name = p.Name
+					return true, nil
+				}
+			}
+			if finished != nil {
+				done, err := finished(ctx)
+				if err != nil {
+					return false, err
+				}
+				if done {
+					return false, errPodsFinished
+				}
+			}
+			log.Printf("[DEBUG] Waiting for a running pod matching %q in namespace %q", s.String(), namespace)
+			return false, nil
+		})
+		if errors.Is(err, errPodsFinished) {
+			return "", err
+		}
+		if err != nil {
+			return "", fmt.Errorf("no running pod matching %q found: %s", s.String(), err)
+		}
+		return name, nil
+	}
+}
+
+// runLifecycleHooks runs the hooks one after the other in the pod returned by
+// findPod, and returns an error as soon as one of them fails. The remaining
+// hooks are skipped if findPod reports that the pods have already finished.
+func runLifecycleHooks(ctx context.Context, meta interface{}, namespace string, findPod podFinderFunc, hooks []lifecycleHook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	for i, h := range hooks {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, h.Timeout)
+			defer cancel()
+
+			pod, err := findPod(ctx)
+			if err != nil {
+				return err
+			}
+			return execInPod(ctx, meta, conn, namespace, pod, h.Container, h.Command)
+		}()
+		if errors.Is(err, errPodsFinished) {
+			log.Printf("[WARN] Skipping %d lifecycle hooks in namespace %q: %s", len(hooks)-i, namespace, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("lifecycle hook %d (%s) failed: %s", i, strings.Join(h.Command, " "), err)
+		}
+	}
+	return nil
+}
+
+// execInPod runs command in a container of a pod, the first one if container is
+// empty, and returns an error holding its standard error output if it fails.
+func execInPod(ctx context.Context, meta interface{}, conn *kubernetes.Clientset, namespace, pod, container string, command []string) error {
+	req := conn.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(meta.(providerMetadata).config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	var stdout, stderr bytes.Buffer
+	log.Printf("[INFO] Running %q in pod %s/%s", command, namespace, pod)
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	log.Printf("[DEBUG] Output of %q in pod %s/%s: %s", command, namespace, pod, stdout.String())
+	if err != nil {
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return fmt.Errorf("%s: %s", err, s)
+		}
+		return err
+	}
+	return nil
+}
+
+// rollBackCreate deletes a resource whose lifecycle hooks failed with hookErr
+// right after it was created. The resource is left in the state, and so is
+// tainted, if it cannot be deleted.
+func rollBackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, hookErr error, deleteFunc schema.DeleteContextFunc) diag.Diagnostics {
+	log.Printf("[INFO] Deleting %s after a failed lifecycle hook", d.Id())
+	diags := diag.FromErr(hookErr)
+	if deleteDiags := deleteFunc(ctx, d, meta); deleteDiags.HasError() {
+		return append(diags, deleteDiags...)
+	}
+	d.SetId("")
+	return diags
+}
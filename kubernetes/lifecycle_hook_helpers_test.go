@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandLifecycleHooks(t *testing.T) {
+	raw := map[string]interface{}{
+		"lifecycle_hook": []interface{}{
+			map[string]interface{}{
+				"on_event": "create",
+				"command":  []interface{}{"sh", "-c", "echo seeded > /tmp/seed"},
+			},
+			map[string]interface{}{
+				"on_event":        "create",
+				"command":         []interface{}{"/bin/notify"},
+				"container":       "sidecar",
+				"timeout_seconds": 5,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"lifecycle_hook": lifecycleHookSchema("the pod")}, raw)
+
+	expected := []lifecycleHook{
+		{Command: []string{"sh", "-c", "echo seeded > /tmp/seed"}, Timeout: 60 * time.Second},
+		{Command: []string{"/bin/notify"}, Container: "sidecar", Timeout: 5 * time.Second},
+	}
+	hooks := expandLifecycleHooks(d.Get("lifecycle_hook").([]interface{}), lifecycleHookEventCreate)
+	if diff := cmp.Diff(expected, hooks); diff != "" {
+		t.Fatalf("unexpected hooks (-want +got):\n%s", diff)
+	}
+	if hooks := expandLifecycleHooks(d.Get("lifecycle_hook").([]interface{}), "delete"); len(hooks) != 0 {
+		t.Fatalf("expected no hooks for the delete event, got: %#v", hooks)
+	}
+}
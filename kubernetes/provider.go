@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	gversion "github.com/hashicorp/go-version"
@@ -28,6 +29,9 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	restclient "k8s.io/client-go/rest"
@@ -125,10 +129,11 @@ func Provider() *schema.Provider {
 				Description: "Token to authenticate an service account",
 			},
 			"proxy_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "URL to the proxy to be used for all API requests",
-				DefaultFunc: schema.EnvDefaultFunc("KUBE_PROXY_URL", ""),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL to the proxy to be used for all API requests",
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_PROXY_URL", ""),
+				ValidateFunc: validateProxyURL,
 			},
 			"exec": {
 				Type:     schema.TypeList,
@@ -137,8 +142,9 @@ func Provider() *schema.Provider {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"api_version": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Description: "API version to use when decoding the ExecCredentials resource, e.g. `client.authentication.k8s.io/v1beta1`.",
+							Required:    true,
 							ValidateDiagFunc: func(val interface{}, key cty.Path) diag.Diagnostics {
 								apiVersion := val.(string)
 								if apiVersion == "client.authentication.k8s.io/v1alpha1" {
@@ -152,22 +158,75 @@ func Provider() *schema.Provider {
 							},
 						},
 						"command": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Description: "Command to execute.",
+							Required:    true,
 						},
 						"env": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeMap,
+							Description: "Map of environment variables to set when executing the plugin.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"args": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+							Description: "List of arguments to pass when executing the plugin.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
-				Description: "",
+				Description: "Configuration block to use an exec-based credential plugin, e.g. call an external command to receive user credentials.",
+			},
+			"oidc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idp_issuer_url": {
+							Type:        schema.TypeString,
+							Description: "URL of the OpenID Connect identity provider issuing the tokens.",
+							Required:    true,
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Description: "Client ID registered with the identity provider.",
+							Required:    true,
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Description: "Client secret registered with the identity provider.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"id_token": {
+							Type:        schema.TypeString,
+							Description: "Initial ID token. If it is missing or expired, a new one is requested using the refresh token.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"refresh_token": {
+							Type:        schema.TypeString,
+							Description: "Refresh token used to request new ID tokens from the identity provider.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"idp_certificate_authority_data": {
+							Type:         schema.TypeString,
+							Description:  "Base64 encoded PEM certificate bundle used to verify the identity provider's TLS certificate.",
+							Optional:     true,
+							ValidateFunc: validateBase64Encoded,
+						},
+						"extra_scopes": {
+							Type:        schema.TypeList,
+							Description: "Additional scopes to request from the identity provider, besides `openid`.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				Description: "Configuration block to authenticate with OpenID Connect tokens. The ID token is cached in memory and refreshed using the refresh token when it expires.",
 			},
 			"experiments": {
 				Type:        schema.TypeList,
@@ -192,6 +251,11 @@ func Provider() *schema.Provider {
 							Description: "Enable the `kubernetes_manifest` resource.",
 							Deprecated:  "The kubernetes_manifest resource is now permanently enabled and no longer considered an experiment. This flag has no effect and will be removed in the near future.",
 						},
+						"dynamic_resource_allocation": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Enable the `resource_claim` blocks of pod specs and the `claims` blocks of container resources. These use the Dynamic Resource Allocation API, which is not stable in Kubernetes yet.",
+						},
 					},
 				},
 			},
@@ -213,6 +277,11 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 			},
+			"validate_referenced_secrets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check at plan time that secrets referenced by resources exist, e.g. the TLS secrets of `kubernetes_ingress_v1`. Requires permission to read those secrets. Secrets created later, e.g. in the same apply or by cert-manager, fail the check.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -229,6 +298,7 @@ func Provider() *schema.Provider {
 			"kubernetes_service_v1":                 dataSourceKubernetesServiceV1(),
 			"kubernetes_pod":                        dataSourceKubernetesPodV1(),
 			"kubernetes_pod_v1":                     dataSourceKubernetesPodV1(),
+			"kubernetes_pod_logs":                   dataSourceKubernetesPodLogs(),
 			"kubernetes_service_account":            dataSourceKubernetesServiceAccountV1(),
 			"kubernetes_service_account_v1":         dataSourceKubernetesServiceAccountV1(),
 			"kubernetes_persistent_volume_v1":       dataSourceKubernetesPersistentVolumeV1(),
@@ -237,9 +307,20 @@ func Provider() *schema.Provider {
 			"kubernetes_nodes":                      dataSourceKubernetesNodes(),
 			"kubernetes_server_version":             dataSourceKubernetesServerVersion(),
 
+			// apps
+			"kubernetes_deployment_v1":   dataSourceKubernetesDeploymentV1(),
+			"kubernetes_stateful_set_v1": dataSourceKubernetesStatefulSetV1(),
+
 			// networking
-			"kubernetes_ingress":    dataSourceKubernetesIngress(),
-			"kubernetes_ingress_v1": dataSourceKubernetesIngressV1(),
+			"kubernetes_ingress":           dataSourceKubernetesIngress(),
+			"kubernetes_ingress_v1":        dataSourceKubernetesIngressV1(),
+			"kubernetes_network_policy_v1": dataSourceKubernetesNetworkPolicyV1(),
+
+			// rbac
+			"kubernetes_cluster_role_v1":         dataSourceKubernetesClusterRoleV1(),
+			"kubernetes_cluster_role_binding_v1": dataSourceKubernetesClusterRoleBindingV1(),
+			"kubernetes_role_v1":                 dataSourceKubernetesRoleV1(),
+			"kubernetes_role_binding_v1":         dataSourceKubernetesRoleBindingV1(),
 
 			// storage
 			"kubernetes_storage_class":    dataSourceKubernetesStorageClassV1(),
@@ -247,6 +328,9 @@ func Provider() *schema.Provider {
 
 			// admission control
 			"kubernetes_mutating_webhook_configuration_v1": dataSourceKubernetesMutatingWebhookConfigurationV1(),
+
+			// autoscaling
+			"kubernetes_vertical_pod_autoscaler_v1": dataSourceKubernetesVerticalPodAutoscalerV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -267,6 +351,7 @@ func Provider() *schema.Provider {
 			"kubernetes_secret_v1_data":             resourceKubernetesSecretV1Data(),
 			"kubernetes_pod":                        resourceKubernetesPodV1(),
 			"kubernetes_pod_v1":                     resourceKubernetesPodV1(),
+			"kubernetes_pod_template_v1":            resourceKubernetesPodTemplateV1(),
 			"kubernetes_endpoints":                  resourceKubernetesEndpointsV1(),
 			"kubernetes_endpoints_v1":               resourceKubernetesEndpointsV1(),
 			"kubernetes_endpoint_slice_v1":          resourceKubernetesEndpointSliceV1(),
@@ -287,6 +372,9 @@ func Provider() *schema.Provider {
 			"kubernetes_api_service":    resourceKubernetesAPIServiceV1(),
 			"kubernetes_api_service_v1": resourceKubernetesAPIServiceV1(),
 
+			// api extensions
+			"kubernetes_custom_resource_definition_v1": resourceKubernetesCustomResourceDefinitionV1(),
+
 			// apps
 			"kubernetes_deployment":      resourceKubernetesDeploymentV1(),
 			"kubernetes_deployment_v1":   resourceKubernetesDeploymentV1(),
@@ -306,10 +394,15 @@ func Provider() *schema.Provider {
 			"kubernetes_horizontal_pod_autoscaler_v1":      resourceKubernetesHorizontalPodAutoscalerV1(),
 			"kubernetes_horizontal_pod_autoscaler_v2beta2": resourceKubernetesHorizontalPodAutoscalerV2Beta2(),
 			"kubernetes_horizontal_pod_autoscaler_v2":      resourceKubernetesHorizontalPodAutoscalerV2(),
+			"kubernetes_vertical_pod_autoscaler_v1":        resourceKubernetesVerticalPodAutoscalerV1(),
 
 			// certificates
 			"kubernetes_certificate_signing_request":    resourceKubernetesCertificateSigningRequest(),
 			"kubernetes_certificate_signing_request_v1": resourceKubernetesCertificateSigningRequestV1(),
+			"kubernetes_cluster_trust_bundle_v1alpha1":  resourceKubernetesClusterTrustBundleV1Alpha1(),
+
+			// coordination
+			"kubernetes_lease_v1": resourceKubernetesLeaseV1(),
 
 			// rbac
 			"kubernetes_role":                    resourceKubernetesRoleV1(),
@@ -339,6 +432,13 @@ func Provider() *schema.Provider {
 			"kubernetes_priority_class":    resourceKubernetesPriorityClassV1(),
 			"kubernetes_priority_class_v1": resourceKubernetesPriorityClassV1(),
 
+			// resource
+			"kubernetes_resource_claim_v1alpha2": resourceKubernetesResourceClaimV1Alpha2(),
+
+			// flowcontrol
+			"kubernetes_flow_schema_v1beta3":                  resourceKubernetesFlowSchemaV1Beta3(),
+			"kubernetes_priority_level_configuration_v1beta3": resourceKubernetesPriorityLevelConfigurationV1Beta3(),
+
 			// admission control
 			"kubernetes_validating_webhook_configuration":    resourceKubernetesValidatingWebhookConfigurationV1Beta1(),
 			"kubernetes_validating_webhook_configuration_v1": resourceKubernetesValidatingWebhookConfigurationV1(),
@@ -352,8 +452,9 @@ func Provider() *schema.Provider {
 			"kubernetes_csi_driver_v1":    resourceKubernetesCSIDriverV1(),
 
 			// provider helper resources
-			"kubernetes_labels":      resourceKubernetesLabels(),
-			"kubernetes_annotations": resourceKubernetesAnnotations(),
+			"kubernetes_labels":                 resourceKubernetesLabels(),
+			"kubernetes_annotations":            resourceKubernetesAnnotations(),
+			"kubernetes_pod_security_admission": resourceKubernetesPodSecurityAdmission(),
 
 			// authentication
 			"kubernetes_token_request_v1": resourceKubernetesTokenRequestV1(),
@@ -378,6 +479,7 @@ func Provider() *schema.Provider {
 type KubeClientsets interface {
 	MainClientset() (*kubernetes.Clientset, error)
 	AggregatorClientset() (*aggregator.Clientset, error)
+	ApiextensionsClientset() (*apiextensions.Clientset, error)
 	DynamicClient() (dynamic.Interface, error)
 	DiscoveryClient() (discovery.DiscoveryInterface, error)
 }
@@ -393,6 +495,10 @@ type providerMetadata struct {
 
 	IgnoreAnnotations []string
 	IgnoreLabels      []string
+
+	ValidateReferencedSecrets bool
+
+	DynamicResourceAllocation bool
 }
 
 func (k providerMetadata) MainClientset() (*kubernetes.Clientset, error) {
@@ -424,6 +530,19 @@ func (k providerMetadata) AggregatorClientset() (*aggregator.Clientset, error) {
 	return k.aggregatorClientset, nil
 }
 
+// ApiextensionsClientset builds a new clientset on every call, as the value
+// receiver cannot keep one for later calls.
+func (k providerMetadata) ApiextensionsClientset() (*apiextensions.Clientset, error) {
+	if k.config == nil {
+		return nil, nil
+	}
+	ac, err := apiextensions.NewForConfig(k.config)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to configure client: %s", err)
+	}
+	return ac, nil
+}
+
 func (k providerMetadata) DynamicClient() (dynamic.Interface, error) {
 	if k.dynamicClient != nil {
 		return k.dynamicClient, nil
@@ -487,14 +606,26 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		ignoreLabels = expandStringSlice(v)
 	}
 
-	m := providerMetadata{
-		config:              cfg,
-		mainClientset:       nil,
-		aggregatorClientset: nil,
-		IgnoreAnnotations:   ignoreAnnotations,
-		IgnoreLabels:        ignoreLabels,
+	warnings := diag.Diagnostics{}
+	dynamicResourceAllocation, _ := d.Get("experiments.0.dynamic_resource_allocation").(bool)
+	if dynamicResourceAllocation {
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Dynamic Resource Allocation is experimental",
+			Detail:   "The Dynamic Resource Allocation API is not stable in Kubernetes and may change in incompatible ways. Support for it in this provider may change accordingly.",
+		})
 	}
-	return m, diag.Diagnostics{}
+
+	m := providerMetadata{
+		config:                    cfg,
+		mainClientset:             nil,
+		aggregatorClientset:       nil,
+		IgnoreAnnotations:         ignoreAnnotations,
+		IgnoreLabels:              ignoreLabels,
+		DynamicResourceAllocation: dynamicResourceAllocation,
+		ValidateReferencedSecrets: d.Get("validate_referenced_secrets").(bool),
+	}
+	return m, warnings
 }
 
 func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.Diagnostics) {
@@ -627,6 +758,22 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 		overrides.AuthInfo.Exec = exec
 	}
 
+	if v, ok := d.GetOk("oidc"); ok {
+		if spec, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			overrides.AuthInfo.AuthProvider = &clientcmdapi.AuthProviderConfig{
+				Name:   "oidc",
+				Config: expandOIDCAuthProviderConfig(spec),
+			}
+		} else {
+			nd := diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Failed to parse 'oidc' provider configuration",
+				AttributePath: cty.Path{}.IndexString("oidc"),
+			}
+			return nil, append(diags, nd)
+		}
+	}
+
 	if v, ok := d.GetOk("proxy_url"); ok {
 		overrides.ClusterDefaults.ProxyURL = v.(string)
 	}
@@ -642,21 +789,70 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, diag.D
 		log.Printf("[WARN] Provider was supplied an invalid configuration. Further operations likely to fail: %v", err)
 		return nil, append(diags, nd)
 	}
+	if overrides.AuthInfo.AuthProvider != nil {
+		// Refreshed tokens are only kept in memory, they must never be
+		// written back to a kubeconfig file.
+		cfg.AuthConfigPersister = inMemoryAuthConfigPersister{}
+	}
 
 	return cfg, diags
 }
 
-var useadmissionregistrationv1beta1 *bool
+// inMemoryAuthConfigPersister discards the auth provider configuration the
+// OIDC plugin persists after refreshing a token. The plugin keeps the
+// refreshed token in its own in-memory cache.
+type inMemoryAuthConfigPersister struct{}
+
+func (inMemoryAuthConfigPersister) Persist(map[string]string) error {
+	return nil
+}
+
+// expandOIDCAuthProviderConfig converts the oidc provider block into the
+// configuration of the client-go OIDC auth provider plugin.
+func expandOIDCAuthProviderConfig(in map[string]interface{}) map[string]string {
+	keys := map[string]string{
+		"idp_issuer_url":                 "idp-issuer-url",
+		"client_id":                      "client-id",
+		"client_secret":                  "client-secret",
+		"id_token":                       "id-token",
+		"refresh_token":                  "refresh-token",
+		"idp_certificate_authority_data": "idp-certificate-authority-data",
+	}
+	cfg := make(map[string]string)
+	for k, key := range keys {
+		if v, ok := in[k].(string); ok && v != "" {
+			cfg[key] = v
+		}
+	}
+	if v, ok := in["extra_scopes"].([]interface{}); ok && len(v) > 0 {
+		cfg["extra-scopes"] = strings.Join(expandStringSlice(v), ",")
+	}
+	return cfg
+}
+
+var (
+	useadmissionregistrationv1beta1 *bool
+	usepolicyv1beta1                *bool
+	useautoscalingv2                *bool
+)
 
 func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
-	if useadmissionregistrationv1beta1 != nil {
-		return *useadmissionregistrationv1beta1, nil
+	return useGroupV1beta1(conn, "admissionregistration.k8s.io", &useadmissionregistrationv1beta1)
+}
+
+func usePolicyV1beta1(conn *kubernetes.Clientset) (bool, error) {
+	return useGroupV1beta1(conn, "policy", &usepolicyv1beta1)
+}
+
+// useGroupV1beta1 reports whether the v1beta1 version of the API group has to be
+// used because the server does not serve v1 yet. The result is cached in cache.
+func useGroupV1beta1(conn *kubernetes.Clientset, group string, cache **bool) (bool, error) {
+	if *cache != nil {
+		return **cache, nil
 	}
 
 	d := conn.Discovery()
 
-	group := "admissionregistration.k8s.io"
-
 	v1, err := apimachineryschema.ParseGroupVersion(fmt.Sprintf("%s/v1", group))
 	if err != nil {
 		return false, err
@@ -665,7 +861,7 @@ func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
 	err = discovery.ServerSupportsVersion(d, v1)
 	if err == nil {
 		log.Printf("[INFO] Using %s/v1", group)
-		useadmissionregistrationv1beta1 = ptr.To(false)
+		*cache = ptr.To(false)
 		return false, nil
 	}
 
@@ -680,10 +876,37 @@ func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
 	}
 
 	log.Printf("[INFO] Using %s/v1beta1", group)
-	useadmissionregistrationv1beta1 = ptr.To(true)
+	*cache = ptr.To(true)
 	return true, nil
 }
 
+// useAutoscalingV2 reports whether the server serves autoscaling/v2. Servers
+// older than Kubernetes 1.23 only serve autoscaling/v2beta2, which in turn was
+// removed in Kubernetes 1.26. The result is cached.
+func useAutoscalingV2(conn *kubernetes.Clientset) (bool, error) {
+	if useautoscalingv2 != nil {
+		return *useautoscalingv2, nil
+	}
+
+	d := conn.Discovery()
+
+	err := discovery.ServerSupportsVersion(d, autoscalingv2.SchemeGroupVersion)
+	if err == nil {
+		log.Printf("[INFO] Using %s", autoscalingv2.SchemeGroupVersion)
+		useautoscalingv2 = ptr.To(true)
+		return true, nil
+	}
+
+	err = discovery.ServerSupportsVersion(d, autoscalingv2beta2.SchemeGroupVersion)
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Using %s", autoscalingv2beta2.SchemeGroupVersion)
+	useautoscalingv2 = ptr.To(false)
+	return false, nil
+}
+
 func getServerVersion(connection *kubernetes.Clientset) (*gversion.Version, error) {
 	sv, err := connection.ServerVersion()
 	if err != nil {
@@ -7,9 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -20,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 // Global constants for testing images (reduces the number of docker pulls).
@@ -102,6 +106,83 @@ func TestProvider_configure_paths(t *testing.T) {
 	}
 }
 
+func TestProvider_configure_oidc(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"host": "https://127.0.0.1:6443",
+		"oidc": []interface{}{map[string]interface{}{
+			"idp_issuer_url": "https://dex.example.com",
+			"client_id":      "kubernetes",
+			"client_secret":  "secret",
+			"refresh_token":  "refresh",
+			"extra_scopes":   []interface{}{"groups", "email"},
+		}},
+	})
+	cfg, diags := initializeConfiguration(d)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if cfg.AuthProvider == nil || cfg.AuthProvider.Name != "oidc" {
+		t.Fatalf("Expected the oidc auth provider, got %#v", cfg.AuthProvider)
+	}
+	expected := map[string]string{
+		"idp-issuer-url": "https://dex.example.com",
+		"client-id":      "kubernetes",
+		"client-secret":  "secret",
+		"refresh-token":  "refresh",
+		"extra-scopes":   "groups,email",
+	}
+	if !reflect.DeepEqual(cfg.AuthProvider.Config, expected) {
+		t.Fatalf("Unexpected oidc configuration: %#v", cfg.AuthProvider.Config)
+	}
+	if _, ok := cfg.AuthConfigPersister.(inMemoryAuthConfigPersister); !ok {
+		t.Fatalf("Expected refreshed tokens to be kept in memory, got %#v", cfg.AuthConfigPersister)
+	}
+}
+
+func TestProvider_configure_proxy(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target.
+		proxied = append(proxied, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"major":"1","minor":"30","gitVersion":"v1.30.0"}`)
+	}))
+	defer proxy.Close()
+
+	// The provider attribute takes precedence over the proxy environment variables.
+	t.Setenv("HTTP_PROXY", "http://127.0.0.1:1")
+	t.Setenv("HTTPS_PROXY", "http://127.0.0.1:1")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"host":      "http://kubernetes.invalid",
+		"proxy_url": proxy.URL,
+	})
+	cfg, diags := initializeConfiguration(d)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	conn, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := conn.Discovery().ServerVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.GitVersion != "v1.30.0" {
+		t.Fatalf("Unexpected server version %q", v.GitVersion)
+	}
+	if len(proxied) != 1 || proxied[0] != "http://kubernetes.invalid/version" {
+		t.Fatalf("Expected the request to go through the proxy, got %#v", proxied)
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
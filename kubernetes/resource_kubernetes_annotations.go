@@ -69,12 +69,16 @@ func resourceKubernetesAnnotations() *schema.Resource {
 				Type:         schema.TypeMap,
 				Description:  "A map of annotations to apply to the resource.",
 				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAnnotations,
 				AtLeastOneOf: []string{"template_annotations", "annotations"},
 			},
 			"template_annotations": {
 				Type:         schema.TypeMap,
 				Description:  "A map of annotations to apply to the resource template.",
 				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAnnotations,
 				AtLeastOneOf: []string{"template_annotations", "annotations"},
 			},
 			"force": {
@@ -204,7 +208,7 @@ func resourceKubernetesAnnotationsRead(ctx context.Context, d *schema.ResourceDa
 func getManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manager string) (map[string]interface{}, error) {
 	var annotations map[string]interface{}
 	for _, m := range managedFields {
-		if m.Manager != manager {
+		if m.Manager != manager || m.FieldsV1 == nil {
 			continue
 		}
 		var mm map[string]interface{}
@@ -227,7 +231,7 @@ func getManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manager string
 func getTemplateManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manager string, kind string) (map[string]interface{}, error) {
 	var annotations map[string]interface{}
 	for _, m := range managedFields {
-		if m.Manager != manager {
+		if m.Manager != manager || m.FieldsV1 == nil {
 			continue
 		}
 		var mm map[string]interface{}
@@ -241,7 +245,7 @@ func getTemplateManagedAnnotations(managedFields []v1.ManagedFieldsEntry, manage
 		}
 		if kind == "CronJob" {
 			if jt, ok := spec["f:jobTemplate"].(map[string]interface{}); ok {
-				spec = jt["f:spec"].(map[string]interface{})
+				spec, _ = jt["f:spec"].(map[string]interface{})
 			}
 		}
 		var template map[string]interface{}
@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -288,6 +289,21 @@ func TestAccKubernetesAnnotations_resource_only(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesAnnotations_invalid(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesAnnotations_invalid(name),
+				ExpectError: regexp.MustCompile(`annotations \("-invalid/key"\)`),
+			},
+		},
+	})
+}
+
 func testAccKubernetesAnnotations_empty(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_annotations" "test" {
   api_version = "v1"
@@ -317,6 +333,21 @@ func testAccKubernetesAnnotations_basic(name string) string {
 `, name)
 }
 
+func testAccKubernetesAnnotations_invalid(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_annotations" "test" {
+  api_version = "v1"
+  kind        = "ConfigMap"
+  metadata {
+    name = %q
+  }
+  annotations = {
+    "-invalid/key" = "one"
+  }
+  field_manager = "tftest"
+}
+`, name)
+}
+
 func testAccKubernetesAnnotations_modified(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_annotations" "test" {
   api_version = "v1"
@@ -38,9 +38,10 @@ func resourceKubernetesAPIServiceV1() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ca_bundle": {
-							Type:        schema.TypeString,
-							Description: "CABundle is a PEM encoded CA bundle which will be used to validate an API server's serving certificate. If unspecified, system trust roots on the apiserver are used.",
-							Optional:    true,
+							Type:         schema.TypeString,
+							Description:  "CABundle is a base64 encoded PEM CA bundle which will be used to validate an API server's serving certificate. If unspecified, system trust roots on the apiserver are used.",
+							Optional:     true,
+							ValidateFunc: validateBase64Encoded,
 						},
 						"group": {
 							Type:        schema.TypeString,
@@ -51,7 +52,7 @@ func resourceKubernetesAPIServiceV1() *schema.Resource {
 							Type:         schema.TypeInt,
 							Description:  "GroupPriorityMinimum is the priority this group should have at least. Higher priority means that the group is preferred by clients over lower priority ones. Note that other versions of this group might specify even higher GroupPriorityMininum values such that the whole group gets a higher priority. The primary sort is based on GroupPriorityMinimum, ordered highest number to lowest (20 before 10). The secondary sort is based on the alphabetical comparison of the name of the object. (v1.bar before v1.foo) We'd recommend something like: *.k8s.io (except extensions) at 18000 and PaaSes (OpenShift, Deis) are recommended to be in the 2000s.",
 							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 20000),
+							ValidateFunc: validation.All(validatePositiveInteger, validation.IntAtMost(20000)),
 						},
 						"insecure_skip_tls_verify": {
 							Type:        schema.TypeBool,
@@ -96,7 +97,45 @@ func resourceKubernetesAPIServiceV1() *schema.Resource {
 							Type:         schema.TypeInt,
 							Description:  "VersionPriority controls the ordering of this API version inside of its group. Must be greater than zero. The primary sort is based on VersionPriority, ordered highest to lowest (20 before 10). Since it's inside of a group, the number can be small, probably in the 10s. In case of equal version priorities, the version string will be used to compute the order inside a group. If the version string is `kube-like`, it will sort above non `kube-like` version strings, which are ordered lexicographically. `Kube-like` versions start with a `v`, then are followed by a number (the major version), then optionally the string `alpha` or `beta` and another number (the minor version). These are sorted first by GA > `beta` > `alpha` (where GA is a version with no suffix such as `beta` or `alpha`), and then by comparing major version, then minor version. An example sorted list of versions: `v10`, `v2`, `v1`, `v11beta2`, `v10beta3`, `v3beta1`, `v12alpha1`, `v11alpha2`, `foo1`, `foo10`.",
 							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 1000),
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status contains derived information about an API server.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Description: "Current service state of the API service, e.g. whether the aggregated API server is reachable.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_transition_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -162,6 +201,11 @@ func resourceKubernetesAPIServiceV1Read(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("status", flattenAPIServiceV1Status(svc.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
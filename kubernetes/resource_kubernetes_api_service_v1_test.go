@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -46,8 +47,15 @@ func TestAccKubernetesAPIServiceV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.version_priority", "1"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.ca_bundle", ""),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.insecure_skip_tls_verify", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
 			{
 				Config: testAccKubernetesAPIServiceV1Config_modified(name, group, version),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -112,6 +120,27 @@ func TestAccKubernetesAPIServiceV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesAPIServiceV1_invalidPriority(t *testing.T) {
+	group := fmt.Sprintf("tf-acc-test-%s.k8s.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	version := "v1"
+	name := fmt.Sprintf("%s.%s", version, group)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesAPIServiceV1Config_priorities(name, group, version, 0, 1),
+				ExpectError: regexp.MustCompile(`group_priority_minimum must be greater than 0`),
+			},
+			{
+				Config:      testAccKubernetesAPIServiceV1Config_priorities(name, group, version, 1, 0),
+				ExpectError: regexp.MustCompile(`expected spec\.0\.version_priority to be in the range \(1 - 1000\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesAPIServiceV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).AggregatorClientset()
 	if err != nil {
@@ -258,3 +287,20 @@ func testAccKubernetesAPIServiceV1Config_modified_local_service(name, group, ver
 }
 `, name, group, version)
 }
+
+func testAccKubernetesAPIServiceV1Config_priorities(name, group, version string, groupPriority, versionPriority int) string {
+	return fmt.Sprintf(`resource "kubernetes_api_service_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    group                  = "%s"
+    group_priority_minimum = %d
+
+    version          = "%s"
+    version_priority = %d
+  }
+}
+`, name, group, groupPriority, version, versionPriority)
+}
@@ -46,6 +46,7 @@ func resourceKubernetesCertificateSigningRequest() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: apiDocStatus["certificate"],
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"metadata": metadataSchemaForceNew(metadataSchema("certificate signing request", true)),
 			"spec": {
@@ -57,10 +58,11 @@ func resourceKubernetesCertificateSigningRequest() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"request": {
-							Type:        schema.TypeString,
-							Description: apiDocSpec["request"],
-							Required:    true,
-							ForceNew:    true,
+							Type:         schema.TypeString,
+							Description:  apiDocSpec["request"],
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateCertificateRequestPEM,
 						},
 						"signer_name": {
 							Type: schema.TypeString,
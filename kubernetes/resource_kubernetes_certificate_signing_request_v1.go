@@ -47,6 +47,7 @@ func resourceKubernetesCertificateSigningRequestV1() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: apiDocStatus["certificate"],
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"metadata": metadataSchemaForceNew(metadataSchema("certificate signing request", true)),
 			"spec": {
@@ -64,10 +65,11 @@ func resourceKubernetesCertificateSigningRequestV1() *schema.Resource {
 							ForceNew:    true,
 						},
 						"request": {
-							Type:        schema.TypeString,
-							Description: apiDocSpec["request"],
-							Required:    true,
-							ForceNew:    true,
+							Type:         schema.TypeString,
+							Description:  apiDocSpec["request"],
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateCertificateRequestPEM,
 						},
 						"signer_name": {
 							Type:        schema.TypeString,
@@ -44,16 +44,7 @@ func resourceKubernetesClusterRoleV1() *schema.Resource {
 				Optional:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cluster_role_selectors": {
-							Type:        schema.TypeList,
-							Description: "A list of selectors which will be used to find ClusterRoles and create the rules.",
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: labelSelectorFields(true),
-							},
-						},
-					},
+					Schema: clusterRoleAggregationRuleSchema(),
 				},
 			},
 		},
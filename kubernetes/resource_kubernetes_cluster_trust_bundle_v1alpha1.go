@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	certificatesv1alpha1 "k8s.io/api/certificates/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesClusterTrustBundleV1Alpha1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A cluster trust bundle is a cluster-scoped container for X.509 trust anchors (root certificates). It requires the `certificates.k8s.io/v1alpha1` API, available in Kubernetes 1.27+ behind the `ClusterTrustBundle` feature gate.",
+		CreateContext: resourceKubernetesClusterTrustBundleV1Alpha1Create,
+		ReadContext:   resourceKubernetesClusterTrustBundleV1Alpha1Read,
+		UpdateContext: resourceKubernetesClusterTrustBundleV1Alpha1Update,
+		DeleteContext: resourceKubernetesClusterTrustBundleV1Alpha1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Names of signer-linked bundles contain colons, so use the relaxed RBAC name validation.
+			"metadata": metadataSchemaRBAC("cluster trust bundle", false, false),
+			"signer_name": {
+				Type:         schema.TypeString,
+				Description:  "Indicates the associated signer, if any. When set, the name of the cluster trust bundle must be prefixed with the signer name, with slashes replaced by colons, followed by a colon, e.g. `example.com:mysigner:`.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateQualifiedName,
+			},
+			"trust_bundle": {
+				Type:         schema.TypeString,
+				Description:  "A list of PEM-encoded X.509 certificates that make up the trust bundle.",
+				Required:     true,
+				ValidateFunc: validatePEMCertificates,
+			},
+		},
+	}
+}
+
+func checkClusterTrustBundleV1Alpha1Available(conn *kubernetes.Clientset) error {
+	err := discovery.ServerSupportsVersion(conn.Discovery(), certificatesv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return fmt.Errorf("the %s API is not available in this cluster; cluster trust bundles require Kubernetes 1.27+ with the ClusterTrustBundle feature gate enabled: %s", certificatesv1alpha1.SchemeGroupVersion, err)
+	}
+	return nil
+}
+
+func resourceKubernetesClusterTrustBundleV1Alpha1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkClusterTrustBundleV1Alpha1Available(conn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctb := certificatesv1alpha1.ClusterTrustBundle{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec: certificatesv1alpha1.ClusterTrustBundleSpec{
+			SignerName:  d.Get("signer_name").(string),
+			TrustBundle: d.Get("trust_bundle").(string),
+		},
+	}
+
+	log.Printf("[INFO] Creating new cluster trust bundle: %#v", ctb)
+	out, err := conn.CertificatesV1alpha1().ClusterTrustBundles().Create(ctx, &ctb, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create cluster trust bundle: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted new cluster trust bundle: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesClusterTrustBundleV1Alpha1Read(ctx, d, meta)
+}
+
+func resourceKubernetesClusterTrustBundleV1Alpha1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesClusterTrustBundleV1Alpha1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading cluster trust bundle %s", name)
+	ctb, err := conn.CertificatesV1alpha1().ClusterTrustBundles().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received cluster trust bundle: %#v", ctb)
+
+	err = d.Set("metadata", flattenMetadata(ctb.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("signer_name", ctb.Spec.SignerName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("trust_bundle", ctb.Spec.TrustBundle)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesClusterTrustBundleV1Alpha1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("trust_bundle") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/trustBundle",
+			Value: d.Get("trust_bundle").(string),
+		})
+	}
+
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating cluster trust bundle %q: %v", name, string(data))
+	out, err := conn.CertificatesV1alpha1().ClusterTrustBundles().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update cluster trust bundle: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated cluster trust bundle: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesClusterTrustBundleV1Alpha1Read(ctx, d, meta)
+}
+
+func resourceKubernetesClusterTrustBundleV1Alpha1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting cluster trust bundle: %#v", name)
+	err = conn.CertificatesV1alpha1().ClusterTrustBundles().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Cluster trust bundle %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesClusterTrustBundleV1Alpha1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking cluster trust bundle %s", name)
+	_, err = conn.CertificatesV1alpha1().ClusterTrustBundles().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+
+	return true, err
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	certificatesv1alpha1 "k8s.io/api/certificates/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testAccClusterTrustBundleCertificate = `-----BEGIN CERTIFICATE-----
+MIIBhzCCAS2gAwIBAgIUMEbpVKYrpme1K4T+oJHW0TWwAOYwCgYIKoZIzj0EAwIw
+GTEXMBUGA1UEAwwOdGYtYWNjLXRlc3QtY2EwHhcNMjYxMDE1MTEwMDE5WhcNMzYx
+MDEyMTEwMDE5WjAZMRcwFQYDVQQDDA50Zi1hY2MtdGVzdC1jYTBZMBMGByqGSM49
+AgEGCCqGSM49AwEHA0IABG3BXi8b/fEKLiq1bpmmfUmna4xmWse8r2X6Q+bszqLR
+NdKi23N2DUGVJcHlhmutg8k8R+1lbal5/AxMQJmdpSejUzBRMB0GA1UdDgQWBBR0
+TuAp3QDMM84kJMJAHd+vjhBAbzAfBgNVHSMEGDAWgBR0TuAp3QDMM84kJMJAHd+v
+jhBAbzAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCICQTZdTrtX0t
+w6PNHdUjeo5evNEpAF43uRUvS0knqo3AAiEA1zWntqhxwYC3wnvrvpjb+qJtVU3N
+xFkkETtIJTba3UI=
+-----END CERTIFICATE-----
+`
+
+func TestAccKubernetesClusterTrustBundleV1Alpha1_basic(t *testing.T) {
+	var conf certificatesv1alpha1.ClusterTrustBundle
+	signerName := fmt.Sprintf("tf-acc-test.example.com/%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	name := fmt.Sprintf("%s:bundle", strings.ReplaceAll(signerName, "/", ":"))
+	resourceName := "kubernetes_cluster_trust_bundle_v1alpha1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoClusterTrustBundleV1Alpha1(t)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesClusterTrustBundleV1Alpha1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterTrustBundleV1Alpha1Config_basic(name, signerName, testAccClusterTrustBundleCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterTrustBundleV1Alpha1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "signer_name", signerName),
+					resource.TestCheckResourceAttr(resourceName, "trust_bundle", testAccClusterTrustBundleCertificate),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClusterTrustBundleV1Alpha1_invalidTrustBundle(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesClusterTrustBundleV1Alpha1Config_basic("tf-acc-test", "tf-acc-test.example.com/signer", "not a certificate"),
+				ExpectError: regexp.MustCompile("must contain at least one PEM encoded certificate"),
+			},
+		},
+	})
+}
+
+func skipIfNoClusterTrustBundleV1Alpha1(t *testing.T) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkClusterTrustBundleV1Alpha1Available(conn); err != nil {
+		t.Skipf("The Kubernetes endpoint does not serve cluster trust bundles - skipping: %s", err)
+	}
+}
+
+func testAccCheckKubernetesClusterTrustBundleV1Alpha1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_cluster_trust_bundle_v1alpha1" {
+			continue
+		}
+
+		resp, err := conn.CertificatesV1alpha1().ClusterTrustBundles().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Cluster trust bundle still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesClusterTrustBundleV1Alpha1Exists(n string, obj *certificatesv1alpha1.ClusterTrustBundle) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out, err := conn.CertificatesV1alpha1().ClusterTrustBundles().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesClusterTrustBundleV1Alpha1Config_basic(name, signerName, trustBundle string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_trust_bundle_v1alpha1" "test" {
+  metadata {
+    name = %q
+  }
+  signer_name  = %q
+  trust_bundle = %q
+}
+`, name, signerName, trustBundle)
+}
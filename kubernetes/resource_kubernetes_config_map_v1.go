@@ -67,7 +67,7 @@ func resourceKubernetesConfigMapV1() *schema.Resource {
 			"immutable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). If not set to true, the field can be modified at any time. Defaulted to nil.",
+				Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). If not set to true, the field can be modified at any time. Defaulted to nil. Changing it from true to false, or changing `data` or `binary_data` while it is true, forces a new config map to be created because the API rejects updates to an immutable config map.",
 			},
 		},
 	}
@@ -191,7 +191,7 @@ func TestAccKubernetesConfigMap_generatedName(t *testing.T) {
 }
 
 func TestAccKubernetesConfigMap_immutable(t *testing.T) {
-	var conf corev1.ConfigMap
+	var conf1, conf2, conf3, conf4 corev1.ConfigMap
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	resourceName := "kubernetes_config_map_v1.test"
 
@@ -205,7 +205,7 @@ func TestAccKubernetesConfigMap_immutable(t *testing.T) {
 			{
 				Config: testAccKubernetesConfigMapV1Config_immutable(name, true, "second"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf),
+					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf1),
 					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
 					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "data.one", "first"),
@@ -213,11 +213,12 @@ func TestAccKubernetesConfigMap_immutable(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
 				),
 			},
-			// change the immutable variable to false
+			// change the immutable variable to false, this replaces the config_map
 			{
 				Config: testAccKubernetesConfigMapV1Config_immutable(name, false, "second"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf),
+					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesConfigMapV1ForceNew(&conf1, &conf2, true),
 					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
 					resource.TestCheckResourceAttr(resourceName, "immutable", "false"),
 					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
@@ -229,7 +230,8 @@ func TestAccKubernetesConfigMap_immutable(t *testing.T) {
 			{
 				Config: testAccKubernetesConfigMapV1Config_immutable(name, false, "third"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf),
+					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf3),
+					testAccCheckKubernetesConfigMapV1ForceNew(&conf2, &conf3, false),
 					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
 					resource.TestCheckResourceAttr(resourceName, "immutable", "false"),
 					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
@@ -237,6 +239,16 @@ func TestAccKubernetesConfigMap_immutable(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data.two", "third"),
 				),
 			},
+			// set immutable back to true without replacing the config_map
+			{
+				Config: testAccKubernetesConfigMapV1Config_immutable(name, true, "third"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapV1Exists(resourceName, &conf4),
+					testAccCheckKubernetesConfigMapV1ForceNew(&conf3, &conf4, false),
+					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data.two", "third"),
+				),
+			},
 		},
 	})
 }
@@ -254,6 +266,21 @@ func testAccCheckConfigMapV1Data(m *corev1.ConfigMap, expected map[string]string
 	}
 }
 
+func testAccCheckKubernetesConfigMapV1ForceNew(old, new *corev1.ConfigMap, wantNew bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if wantNew {
+			if old.ObjectMeta.UID == new.ObjectMeta.UID {
+				return fmt.Errorf("Expecting forced replacement")
+			}
+		} else {
+			if old.ObjectMeta.UID != new.ObjectMeta.UID {
+				return fmt.Errorf("Unexpected forced replacement")
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesConfigMapV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -17,6 +17,7 @@ import (
 
 	batch "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesCronJobV1() *schema.Resource {
@@ -26,6 +27,7 @@ func resourceKubernetesCronJobV1() *schema.Resource {
 		ReadContext:   resourceKubernetesCronJobV1Read,
 		UpdateContext: resourceKubernetesCronJobV1Update,
 		DeleteContext: resourceKubernetesCronJobV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.job_template.0.spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,6 +60,9 @@ func resourceKubernetesCronJobV1Create(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.JobTemplate.Spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
 
 	job := batch.CronJob{
 		ObjectMeta: metadata,
@@ -82,16 +87,29 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	namespace, _, err := idParts(d.Id())
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if patch, ok := cronJobSuspendPatch(d, cronJobSpecFieldsV1()); ok {
+		log.Printf("[INFO] Patching cron job %s: %s", d.Id(), patch)
+		out, err := conn.BatchV1().CronJobs(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Submitted patched cron job: %#v", out)
+		return resourceKubernetesCronJobV1Read(ctx, d, meta)
+	}
+
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec, err := expandCronJobSpecV1(d.Get("spec").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.JobTemplate.Spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
 
 	cronjob := &batch.CronJob{
 		ObjectMeta: metadata,
@@ -110,6 +128,21 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 	return resourceKubernetesCronJobV1Read(ctx, d, meta)
 }
 
+// cronJobSuspendPatch returns a strategic merge patch of spec.suspend when it
+// is the only change to the cron job, so that suspending or resuming it leaves
+// the rest of the object untouched.
+func cronJobSuspendPatch(d *schema.ResourceData, specFields map[string]*schema.Schema) ([]byte, bool) {
+	if d.HasChange("metadata") || !d.HasChange("spec.0.suspend") {
+		return nil, false
+	}
+	for k := range specFields {
+		if k != "suspend" && d.HasChange("spec.0."+k) {
+			return nil, false
+		}
+	}
+	return []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, d.Get("spec.0.suspend").(bool))), true
+}
+
 func resourceKubernetesCronJobV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesCronJobV1Exists(ctx, d, meta)
 	if err != nil {
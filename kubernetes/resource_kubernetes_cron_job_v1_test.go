@@ -82,6 +82,50 @@ func TestAccKubernetesCronJobV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCronJobV1_suspend(t *testing.T) {
+	var conf1, conf2 batchv1.CronJob
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+	resourceName := "kubernetes_cron_job_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.25.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCronJobV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCronJobV1Config_suspend(name, imageName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.suspend", "false"),
+				),
+			},
+			{
+				Config: testAccKubernetesCronJobV1Config_suspend(name, imageName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.suspend", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.job_template.0.spec.0.template.0.spec.0.container.0.image", imageName),
+					testAccCheckKubernetesCronJobV1ForceNew(&conf1, &conf2, false),
+				),
+			},
+			{
+				Config: testAccKubernetesCronJobV1Config_suspend(name, imageName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.suspend", "false"),
+					testAccCheckKubernetesCronJobV1ForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesCronJobV1_extra(t *testing.T) {
 	var conf batchv1.CronJob
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -378,6 +422,33 @@ func testAccKubernetesCronJobV1Config_basic(name, imageName string) string {
 }`, name, imageName)
 }
 
+func testAccKubernetesCronJobV1Config_suspend(name, imageName string, suspend bool) string {
+	return fmt.Sprintf(`resource "kubernetes_cron_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    schedule = "1 0 * * *"
+    suspend  = %t
+    job_template {
+      metadata {}
+      spec {
+        template {
+          metadata {}
+          spec {
+            container {
+              name    = "hello"
+              image   = "%s"
+              command = ["echo", "'hello'"]
+            }
+          }
+        }
+      }
+    }
+  }
+}`, name, suspend, imageName)
+}
+
 func testAccKubernetesCronJobV1Config_modified(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_cron_job_v1" "test" {
   metadata {
@@ -16,6 +16,7 @@ import (
 	"k8s.io/api/batch/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func resourceKubernetesCronJobV1Beta1() *schema.Resource {
@@ -24,6 +25,7 @@ func resourceKubernetesCronJobV1Beta1() *schema.Resource {
 		ReadContext:   resourceKubernetesCronJobV1Beta1Read,
 		UpdateContext: resourceKubernetesCronJobV1Beta1Update,
 		DeleteContext: resourceKubernetesCronJobV1Beta1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.job_template.0.spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -93,11 +95,21 @@ func resourceKubernetesCronJobV1Beta1Update(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	namespace, _, err := idParts(d.Id())
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if patch, ok := cronJobSuspendPatch(d, cronJobSpecFieldsV1Beta1()); ok {
+		log.Printf("[INFO] Patching cron job %s: %s", d.Id(), patch)
+		out, err := conn.BatchV1beta1().CronJobs(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Submitted patched cron job: %#v", out)
+		return resourceKubernetesCronJobV1Beta1Read(ctx, d, meta)
+	}
+
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec, err := expandCronJobSpecV1Beta1(d.Get("spec").([]interface{}))
 	if err != nil {
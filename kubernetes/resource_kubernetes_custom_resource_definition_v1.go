@@ -0,0 +1,531 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func resourceKubernetesCustomResourceDefinitionV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A CustomResourceDefinition extends the Kubernetes API with a new resource type, e.g. the custom resources of an operator.",
+		CreateContext: resourceKubernetesCustomResourceDefinitionV1Create,
+		ReadContext:   resourceKubernetesCustomResourceDefinitionV1Read,
+		UpdateContext: resourceKubernetesCustomResourceDefinitionV1Update,
+		DeleteContext: resourceKubernetesCustomResourceDefinitionV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("custom resource definition", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec describes how the user wants the resources to appear. More info: https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Description: "The API group of the defined custom resources, e.g. `stable.example.com`. The custom resources are served under `/apis/<group>/...`. Must match the name of the custom resource definition, in the form `<names.plural>.<group>`.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"names": {
+							Type:        schema.TypeList,
+							Description: "The resource and kind names of the custom resources.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "The serialized kind of the resource, normally CamelCase and singular. Custom resource instances use this value as the `kind` attribute in API calls.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"plural": {
+										Type:        schema.TypeString,
+										Description: "The plural name of the resource to serve, used in the URL `/apis/<group>/<version>/.../<plural>`. Must be all lowercase.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"singular": {
+										Type:        schema.TypeString,
+										Description: "The singular name of the resource. Must be all lowercase. Defaults to the lowercased `kind`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"list_kind": {
+										Type:        schema.TypeString,
+										Description: "The serialized kind of the list for this resource. Defaults to `<kind>List`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"short_names": {
+										Type:        schema.TypeList,
+										Description: "Short names for the resource, exposed in API discovery documents, e.g. for use with `kubectl get <shortname>`. Must be all lowercase.",
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"categories": {
+										Type:        schema.TypeList,
+										Description: "Grouped resources this custom resource belongs to, e.g. `all`, used by clients such as `kubectl get all`.",
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Description: "Indicates whether the defined custom resources are cluster-scoped or namespace-scoped. Allowed values are `Cluster` and `Namespaced`.",
+							Required:    true,
+							ForceNew:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apiextensionsv1.ClusterScoped),
+								string(apiextensionsv1.NamespaceScoped),
+							}, false),
+						},
+						"version": {
+							Type:        schema.TypeList,
+							Description: "The API versions of the defined custom resources. Exactly one version must be marked as the storage version.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The version name, e.g. `v1`, `v2beta1`. The custom resources are served under this version at `/apis/<group>/<version>/...`.",
+										Required:    true,
+									},
+									"served": {
+										Type:        schema.TypeBool,
+										Description: "Whether this version is served by the REST API.",
+										Required:    true,
+									},
+									"storage": {
+										Type:        schema.TypeBool,
+										Description: "Whether this version is used when persisting custom resources to storage. There must be exactly one version with `storage = true`.",
+										Required:    true,
+									},
+									"deprecated": {
+										Type:        schema.TypeBool,
+										Description: "Whether this version is deprecated. Requests to a deprecated version return a warning header.",
+										Optional:    true,
+									},
+									"deprecation_warning": {
+										Type:        schema.TypeString,
+										Description: "Overrides the default warning returned to API clients for a deprecated version.",
+										Optional:    true,
+									},
+									"schema": {
+										Type:        schema.TypeList,
+										Description: "The schema used for validation, pruning, and defaulting of this version of the custom resource.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"open_api_v3_schema": {
+													Type:             schema.TypeString,
+													Description:      "The OpenAPI v3 schema to use for validation and pruning, encoded as JSON, e.g. with `jsonencode()`.",
+													Required:         true,
+													ValidateFunc:     validation.StringIsJSON,
+													DiffSuppressFunc: structure.SuppressJsonDiff,
+												},
+											},
+										},
+									},
+									"subresources": {
+										Type:        schema.TypeList,
+										Description: "The subresources this version of the custom resource has.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:        schema.TypeBool,
+													Description: "Enables the status subresource for the custom resource.",
+													Optional:    true,
+												},
+												"scale": {
+													Type:        schema.TypeList,
+													Description: "Enables the scale subresource for the custom resource.",
+													Optional:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"spec_replicas_path": {
+																Type:        schema.TypeString,
+																Description: "The JSON path inside of a custom resource that corresponds to `Scale.Spec.Replicas`, e.g. `.spec.replicas`.",
+																Required:    true,
+															},
+															"status_replicas_path": {
+																Type:        schema.TypeString,
+																Description: "The JSON path inside of a custom resource that corresponds to `Scale.Status.Replicas`, e.g. `.status.replicas`.",
+																Required:    true,
+															},
+															"label_selector_path": {
+																Type:        schema.TypeString,
+																Description: "The JSON path inside of a custom resource that corresponds to `Scale.Status.Selector`, e.g. `.status.selector`.",
+																Optional:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"additional_printer_column": {
+										Type:        schema.TypeList,
+										Description: "Additional columns returned in table output, e.g. by `kubectl get`. If no columns are specified, a single column displaying the age of the custom resource is used.",
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:        schema.TypeString,
+													Description: "A human readable name for the column.",
+													Required:    true,
+												},
+												"type": {
+													Type:        schema.TypeString,
+													Description: "An OpenAPI type definition for this column, e.g. `string`, `integer` or `date`.",
+													Required:    true,
+												},
+												"json_path": {
+													Type:        schema.TypeString,
+													Description: "A simple JSON path, evaluated against each custom resource to produce the value for this column.",
+													Required:    true,
+												},
+												"description": {
+													Type:        schema.TypeString,
+													Description: "A human readable description of this column.",
+													Optional:    true,
+												},
+												"format": {
+													Type:        schema.TypeString,
+													Description: "An optional OpenAPI type definition for this column, e.g. `name`.",
+													Optional:    true,
+												},
+												"priority": {
+													Type:        schema.TypeInt,
+													Description: "An integer defining the relative importance of this column compared to others. Lower numbers are considered higher priority.",
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"conversion": {
+							Type:        schema.TypeList,
+							Description: "Defines conversion settings for the custom resource.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"strategy": {
+										Type:        schema.TypeString,
+										Description: "Specifies how custom resources are converted between versions. Allowed values are `None` and `Webhook`.",
+										Optional:    true,
+										Default:     string(apiextensionsv1.NoneConverter),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(apiextensionsv1.NoneConverter),
+											string(apiextensionsv1.WebhookConverter),
+										}, false),
+									},
+									"webhook": {
+										Type:        schema.TypeList,
+										Description: "Describes how to call the conversion webhook. Required when `strategy` is `Webhook`.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"client_config": {
+													Type:        schema.TypeList,
+													Description: "The instructions for how to call the webhook if the strategy is `Webhook`.",
+													Required:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: webhookClientConfigFields(),
+													},
+												},
+												"conversion_review_versions": {
+													Type:        schema.TypeList,
+													Description: "An ordered list of preferred `ConversionReview` versions the webhook expects.",
+													Optional:    true,
+													Computed:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"preserve_unknown_fields": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that object fields which are not specified in the OpenAPI schema should be preserved when persisting to storage.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status indicates the actual state of the custom resource definition.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Description: "The latest available observations of the custom resource definition's current state, e.g. whether its names were accepted and it is established.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_transition_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"message": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"stored_versions": {
+							Type:        schema.TypeList,
+							Description: "The versions of custom resources that were ever persisted.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCustomResourceDefinitionV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := expandCustomResourceDefinitionV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	crd := apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new custom resource definition: %#v", crd)
+	out, err := conn.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, &crd, metav1.CreateOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Submitted new custom resource definition: %#v", out)
+	d.SetId(out.ObjectMeta.Name)
+
+	// Custom resources of the definition can only be created once it is
+	// established, which may be later in the same apply.
+	err = waitForCustomResourceDefinitionV1Established(ctx, conn, out.ObjectMeta.Name, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesCustomResourceDefinitionV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesCustomResourceDefinitionV1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading custom resource definition %s", name)
+	crd, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received custom resource definition: %#v", crd)
+	err = d.Set("metadata", flattenMetadata(crd.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattened, err := flattenCustomResourceDefinitionV1Spec(crd.Spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Flattened custom resource definition spec: %#v", flattened)
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", flattenCustomResourceDefinitionV1Status(crd.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		spec, err := expandCustomResourceDefinitionV1Spec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating custom resource definition %q: %v", name, string(data))
+	out, err := conn.ApiextensionsV1().CustomResourceDefinitions().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update custom resource definition: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated custom resource definition: %#v", out)
+	d.SetId(out.ObjectMeta.Name)
+
+	return resourceKubernetesCustomResourceDefinitionV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesCustomResourceDefinitionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting custom resource definition: %#v", name)
+	err = conn.ApiextensionsV1().CustomResourceDefinitions().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Custom resource definition %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCustomResourceDefinitionV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking custom resource definition %s", name)
+	_, err = conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// waitForCustomResourceDefinitionV1Established watches the custom resource
+// definition until it is established, i.e. its custom resources are served.
+func waitForCustomResourceDefinitionV1Established(ctx context.Context, conn *apiextensions.Clientset, name string, timeout time.Duration) error {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.ApiextensionsV1().CustomResourceDefinitions().List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.ApiextensionsV1().CustomResourceDefinitions().Watch(ctx, options)
+		})
+	desc := fmt.Sprintf("CustomResourceDefinition %s", name)
+	_, err := waitForObject(ctx, lw, &apiextensionsv1.CustomResourceDefinition{}, desc, "be established", timeout, func(obj runtime.Object) (bool, string, error) {
+		if obj == nil {
+			return false, "", fmt.Errorf("%s was deleted while waiting for it to be established", desc)
+		}
+		return customResourceDefinitionV1EstablishedStatus(obj.(*apiextensionsv1.CustomResourceDefinition))
+	})
+	return err
+}
+
+// customResourceDefinitionV1EstablishedStatus reports whether the custom
+// resource definition is established. Names that conflict with another
+// definition are reported as an error, as they are never accepted.
+func customResourceDefinitionV1EstablishedStatus(crd *apiextensionsv1.CustomResourceDefinition) (bool, string, error) {
+	for _, c := range crd.Status.Conditions {
+		switch {
+		case c.Type == apiextensionsv1.Established && c.Status == apiextensionsv1.ConditionTrue:
+			return true, "", nil
+		case c.Type == apiextensionsv1.NamesAccepted && c.Status == apiextensionsv1.ConditionFalse:
+			return false, "", fmt.Errorf("The names of CustomResourceDefinition %s were not accepted: %s", crd.Name, c.Message)
+		}
+	}
+	return false, "Waiting for the definition to be established", nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesCustomResourceDefinitionV1_basic(t *testing.T) {
+	group := fmt.Sprintf("tf-acc-test-%s.example.com", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	kind := "CronTab"
+	plural := "crontabs"
+	name := fmt.Sprintf("%s.%s", plural, group)
+	resourceName := "kubernetes_custom_resource_definition_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceDefinitionV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCustomResourceDefinitionV1Config_basic(name, group, kind, plural, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.group", group),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope", "Namespaced"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.kind", kind),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.plural", plural),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.singular", "crontab"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.names.0.list_kind", "CronTabList"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.name", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.served", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.storage", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "spec.0.version.0.schema.0.open_api_v3_schema"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.conversion.0.strategy", "None"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.stored_versions.0", "v1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesCustomResourceDefinitionV1Config_basic(name, group, kind, plural, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceDefinitionV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.subresources.0.status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.additional_printer_column.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.version.0.additional_printer_column.0.json_path", ".spec.cronSpec"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).ApiextensionsClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_custom_resource_definition_v1" {
+			continue
+		}
+
+		name := rs.Primary.ID
+
+		resp, err := conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Custom resource definition still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCustomResourceDefinitionV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).ApiextensionsClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = conn.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesCustomResourceDefinitionV1Config_basic(name, group, kind, plural string, subresources bool) string {
+	extra := ""
+	if subresources {
+		extra = `
+      subresources {
+        status = true
+      }
+
+      additional_printer_column {
+        name      = "Spec"
+        type      = "string"
+        json_path = ".spec.cronSpec"
+      }`
+	}
+	return fmt.Sprintf(`resource "kubernetes_custom_resource_definition_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    group = "%s"
+    scope = "Namespaced"
+
+    names {
+      kind   = "%s"
+      plural = "%s"
+    }
+
+    version {
+      name    = "v1"
+      served  = true
+      storage = true
+
+      schema {
+        open_api_v3_schema = jsonencode({
+          type = "object"
+          properties = {
+            spec = {
+              type = "object"
+              properties = {
+                cronSpec = { type = "string" }
+                replicas = { type = "integer" }
+              }
+            }
+            status = {
+              type                                   = "object"
+              "x-kubernetes-preserve-unknown-fields" = true
+            }
+          }
+        })
+      }
+%s
+    }
+  }
+}
+`, name, group, kind, plural, extra)
+}
+
+func TestCustomResourceDefinitionV1EstablishedStatus(t *testing.T) {
+	cases := map[string]struct {
+		Conditions []apiextensionsv1.CustomResourceDefinitionCondition
+		Done       bool
+		Error      bool
+	}{
+		"no conditions": {},
+		"names accepted": {
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.NamesAccepted, Status: apiextensionsv1.ConditionTrue},
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionFalse},
+			},
+		},
+		"established": {
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.NamesAccepted, Status: apiextensionsv1.ConditionTrue},
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+			Done: true,
+		},
+		"names not accepted": {
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.NamesAccepted, Status: apiextensionsv1.ConditionFalse, Reason: "MultipleNamesNotAllowed"},
+			},
+			Error: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			crd := &apiextensionsv1.CustomResourceDefinition{
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{Conditions: tc.Conditions},
+			}
+			done, status, err := customResourceDefinitionV1EstablishedStatus(crd)
+			if (err != nil) != tc.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != tc.Done {
+				t.Fatalf("expected done to be %t, got %t (%s)", tc.Done, done, status)
+			}
+		})
+	}
+}
@@ -11,14 +11,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -29,6 +30,7 @@ func resourceKubernetesDaemonSetV1() *schema.Resource {
 		ReadContext:   resourceKubernetesDaemonSetV1Read,
 		UpdateContext: resourceKubernetesDaemonSetV1Update,
 		DeleteContext: resourceKubernetesDaemonSetV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -138,7 +140,7 @@ func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
 		},
 		"wait_for_rollout": {
 			Type:        schema.TypeBool,
-			Description: "Wait for the rollout of the deployment to complete. Defaults to true.",
+			Description: "Wait for the rollout of the daemon set to complete, i.e. for all scheduled pods to be updated, ready and available, within the create or update timeout. Daemon sets using the OnDelete update strategy are not waited for. Defaults to true.",
 			Default:     true,
 			Optional:    true,
 		},
@@ -161,27 +163,37 @@ func resourceKubernetesDaemonSetV1Create(ctx context.Context, d *schema.Resource
 		ObjectMeta: metadata,
 		Spec:       spec,
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := podSpecWindowsWarnings(&spec.Template.Spec)
+	diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
+	diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
 
 	log.Printf("[INFO] Creating new daemonset: %#v", daemonset)
 
 	out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to create daemonset: %s", err)
-	}
-
-	if d.Get("wait_for_rollout").(bool) {
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDaemonSetReplicasFunc(ctx, conn, metadata.Namespace, metadata.Name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		return append(diags, diag.Errorf("Failed to create daemonset: %s", err)...)
 	}
 
 	d.SetId(buildId(out.ObjectMeta))
 
 	log.Printf("[INFO] Submitted new daemonset: %#v", out)
 
-	return resourceKubernetesDaemonSetV1Read(ctx, d, meta)
+	if d.Get("wait_for_rollout").(bool) {
+		if out.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			diags = append(diags, daemonSetOnDeleteWarning(d.Id())...)
+		} else {
+			log.Printf("[INFO] Waiting for daemonset %s to rollout", d.Id())
+			err = waitForDaemonSetRollout(ctx, conn, out.GetNamespace(), out.GetName(), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	return append(diags, resourceKubernetesDaemonSetV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -197,11 +209,18 @@ func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.Resource
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
+	var diags diag.Diagnostics
 	if d.HasChange("spec") {
 		spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+			return diag.FromErr(err)
+		}
+		diags = podSpecWindowsWarnings(&spec.Template.Spec)
+		diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
+		diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -216,19 +235,23 @@ func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.Resource
 
 	out, err := conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update daemonset: %s", err)
+		return append(diags, diag.Errorf("Failed to update daemonset: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
 
 	if d.Get("wait_for_rollout").(bool) {
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
+		if out.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			diags = append(diags, daemonSetOnDeleteWarning(d.Id())...)
+		} else {
+			log.Printf("[INFO] Waiting for daemonset %s to rollout", d.Id())
+			err = waitForDaemonSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
 		}
 	}
 
-	return resourceKubernetesDaemonSetV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesDaemonSetV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesDaemonSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -328,22 +351,58 @@ func resourceKubernetesDaemonSetV1Exists(ctx context.Context, d *schema.Resource
 	return true, err
 }
 
-func waitForDaemonSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) retry.RetryFunc {
-	return func() *retry.RetryError {
-		daemonSet, err := conn.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+// waitForDaemonSetRollout watches the daemon set until its rollout is
+// complete or the timeout expires.
+func waitForDaemonSetRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.AppsV1().DaemonSets(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.AppsV1().DaemonSets(ns).Watch(ctx, options)
+		})
+	obj, err := waitForRollout(ctx, lw, &appsv1.DaemonSet{}, fmt.Sprintf("DaemonSet %s/%s", ns, name), timeout, func(obj runtime.Object) (bool, string, error) {
+		return daemonSetRolloutStatus(obj.(*appsv1.DaemonSet))
+	})
+	if err == nil {
+		return nil
+	}
+	if ds, ok := obj.(*appsv1.DaemonSet); ok {
+		return withPodProblems(ctx, conn, ns, ds.Spec.Selector, err)
+	}
+	return err
+}
+
+// daemonSetRolloutStatus reports whether all scheduled pods of the daemon set
+// run the current template and are ready and available.
+func daemonSetRolloutStatus(ds *appsv1.DaemonSet) (bool, string, error) {
+	if ds.Generation > ds.Status.ObservedGeneration {
+		return false, "Waiting for rollout to start", nil
+	}
 
-		desiredReplicas := daemonSet.Status.DesiredNumberScheduled
-		log.Printf("[DEBUG] Current number of labelled replicas of %q: %d (of %d)\n",
-			daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled, desiredReplicas)
+	desired := ds.Status.DesiredNumberScheduled
+	if ds.Status.UpdatedNumberScheduled < desired {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d out of %d new pods have been updated...", ds.Status.UpdatedNumberScheduled, desired), nil
+	}
 
-		if daemonSet.Status.CurrentNumberScheduled == desiredReplicas {
-			return nil
-		}
+	if ds.Status.NumberReady < desired {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d of %d pods are ready...", ds.Status.NumberReady, desired), nil
+	}
 
-		return retry.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be scheduled (%d)",
-			desiredReplicas, daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled))
+	if ds.Status.NumberAvailable < desired {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d of %d updated pods are available...", ds.Status.NumberAvailable, desired), nil
 	}
+
+	return true, "", nil
+}
+
+// daemonSetOnDeleteWarning is returned instead of waiting for the rollout of
+// daemon sets using the OnDelete strategy, whose pods are only replaced
+// when they are deleted.
+func daemonSetOnDeleteWarning(id string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Not waiting for the rollout of DaemonSet %s", id),
+		Detail:   "The DaemonSet uses the OnDelete update strategy, so its pods are only updated when they are deleted and the rollout cannot be waited for. Set wait_for_rollout to false to silence this warning.",
+	}}
 }
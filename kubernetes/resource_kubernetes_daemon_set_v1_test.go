@@ -1329,3 +1329,48 @@ func testAccKubernetesDaemonSetV1ConfigMinimalWithTemplateNamespace(name, imageN
 }
 `, name, imageName)
 }
+
+func TestDaemonSetRolloutStatus(t *testing.T) {
+	cases := map[string]struct {
+		DaemonSet appsv1.DaemonSet
+		Done      bool
+	}{
+		"spec update not observed": {
+			DaemonSet: appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 2, NumberAvailable: 2},
+			},
+		},
+		"pods not updated": {
+			DaemonSet: appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 1, NumberReady: 2, NumberAvailable: 2},
+			},
+		},
+		"pods not ready": {
+			DaemonSet: appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 1},
+			},
+		},
+		"complete": {
+			DaemonSet: appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberReady: 2, NumberAvailable: 2},
+			},
+			Done: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, status, err := daemonSetRolloutStatus(&tc.DaemonSet)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != tc.Done {
+				t.Fatalf("expected done to be %t, got %t (%s)", tc.Done, done, status)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,7 +18,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -35,6 +36,7 @@ func resourceKubernetesDeploymentV1() *schema.Resource {
 		ReadContext:   resourceKubernetesDeploymentV1Read,
 		UpdateContext: resourceKubernetesDeploymentV1Update,
 		DeleteContext: resourceKubernetesDeploymentV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -64,154 +66,13 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 			Required:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"min_ready_seconds": {
-						Type:        schema.TypeInt,
-						Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
-						Optional:    true,
-						Default:     0,
-					},
-					"paused": {
-						Type:        schema.TypeBool,
-						Description: "Indicates that the deployment is paused.",
-						Optional:    true,
-						Default:     false,
-					},
-					"progress_deadline_seconds": {
-						Type:        schema.TypeInt,
-						Description: "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
-						Optional:    true,
-						Default:     600,
-					},
-					"replicas": {
-						Type:         schema.TypeString,
-						Description:  "Number of desired pods. This is a string to be able to distinguish between explicit zero and not specified.",
-						Optional:     true,
-						Computed:     true,
-						ValidateFunc: validateTypeStringNullableInt,
-					},
-					"revision_history_limit": {
-						Type:        schema.TypeInt,
-						Description: "The number of old ReplicaSets to retain to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 10.",
-						Optional:    true,
-						Default:     10,
-					},
-					"selector": {
-						Type:        schema.TypeList,
-						Description: "A label query over pods that should match the Replicas count.",
-						Optional:    true,
-						ForceNew:    true,
-						MaxItems:    1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"match_expressions": {
-									Type:        schema.TypeList,
-									Description: "A list of label selector requirements. The requirements are ANDed.",
-									Optional:    true,
-									ForceNew:    true,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"key": {
-												Type:        schema.TypeString,
-												Description: "The label key that the selector applies to.",
-												Optional:    true,
-												ForceNew:    true,
-											},
-											"operator": {
-												Type:        schema.TypeString,
-												Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`.",
-												Optional:    true,
-												ForceNew:    true,
-											},
-											"values": {
-												Type:        schema.TypeSet,
-												Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch.",
-												Optional:    true,
-												ForceNew:    true,
-												Elem:        &schema.Schema{Type: schema.TypeString},
-												Set:         schema.HashString,
-											},
-										},
-									},
-								},
-								"match_labels": {
-									Type:        schema.TypeMap,
-									Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-									Optional:    true,
-									ForceNew:    true,
-								},
-							},
-						},
-					},
-					"strategy": {
-						Type:        schema.TypeList,
-						Description: "The deployment strategy to use to replace existing pods with new ones.",
-						Optional:    true,
-						Computed:    true,
-						MaxItems:    1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"type": {
-									Type:         schema.TypeString,
-									Description:  "Type of deployment. Can be 'Recreate' or 'RollingUpdate'. Default is RollingUpdate.",
-									Optional:     true,
-									Default:      "RollingUpdate",
-									ValidateFunc: validation.StringInSlice([]string{"RollingUpdate", "Recreate"}, false),
-								},
-								"rolling_update": {
-									Type:        schema.TypeList,
-									Description: "Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate.",
-									Optional:    true,
-									Computed:    true,
-									MaxItems:    1,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"max_surge": {
-												Type:         schema.TypeString,
-												Description:  "The maximum number of pods that can be scheduled above the desired number of pods. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%. Example: when this is set to 30%, the new RC can be scaled up immediately when the rolling update starts, such that the total number of old and new pods do not exceed 130% of desired pods. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of desired pods.",
-												Optional:     true,
-												Default:      "25%",
-												ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
-											},
-											"max_unavailable": {
-												Type:         schema.TypeString,
-												Description:  "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
-												Optional:     true,
-												Default:      "25%",
-												ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					"template": {
-						Type:        schema.TypeList,
-						Description: "Template describes the pods that will be created.",
-						Required:    true,
-						MaxItems:    1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"metadata": namespacedMetadataSchemaIsTemplate("pod", true, true),
-								"spec": {
-									Type:        schema.TypeList,
-									Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps",
-									Required:    true,
-									MaxItems:    1,
-									Elem: &schema.Resource{
-										Schema: deploymentPodTemplateSpecFields(),
-									},
-								},
-							},
-						},
-					},
-				},
+				Schema: deploymentSpecFields(),
 			},
 		},
+		"lifecycle_hook": lifecycleHookSchema("a running pod of the deployment"),
 		"wait_for_rollout": {
 			Type:        schema.TypeBool,
-			Description: "Wait for the rollout of the deployment to complete. Defaults to true.",
+			Description: "Wait for the rollout of the deployment to complete, i.e. for all replicas to be updated and available, within the create or update timeout. If the rollout stalls, the error reports the pods that are failing, e.g. because of image pull errors or failing readiness probes. Defaults to true.",
 			Default:     true,
 			Optional:    true,
 		},
@@ -244,11 +105,17 @@ func resourceKubernetesDeploymentV1Create(ctx context.Context, d *schema.Resourc
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := podSpecWindowsWarnings(&spec.Template.Spec)
+	diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
+	diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
 
 	log.Printf("[INFO] Creating new deployment: %#v", deployment)
 	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to create deployment: %s", err)
+		return append(diags, diag.Errorf("Failed to create deployment: %s", err)...)
 	}
 
 	d.SetId(buildId(out.ObjectMeta))
@@ -257,16 +124,20 @@ func resourceKubernetesDeploymentV1Create(ctx context.Context, d *schema.Resourc
 
 	if d.Get("wait_for_rollout").(bool) {
 		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+		err := waitForDeploymentRollout(ctx, conn, out.GetNamespace(), out.GetName(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
 	log.Printf("[INFO] Submitted new deployment: %#v", out)
 
-	return resourceKubernetesDeploymentV1Read(ctx, d, meta)
+	hooks := expandLifecycleHooks(d.Get("lifecycle_hook").([]interface{}), lifecycleHookEventCreate)
+	if err := runLifecycleHooks(ctx, meta, out.Namespace, runningPodBySelector(conn, out.Namespace, out.Spec.Selector, nil), hooks); err != nil {
+		return append(diags, rollBackCreate(ctx, d, meta, err, resourceKubernetesDeploymentV1Delete)...)
+	}
+
+	return append(diags, resourceKubernetesDeploymentV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -282,11 +153,18 @@ func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.Resourc
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
+	var diags diag.Diagnostics
 	if d.HasChange("spec") {
 		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+			return diag.FromErr(err)
+		}
+		diags = podSpecWindowsWarnings(&spec.Template.Spec)
+		diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
+		diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -311,20 +189,19 @@ func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.Resourc
 	log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
 	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update deployment: %s", err)
+		return append(diags, diag.Errorf("Failed to update deployment: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
 	if d.Get("wait_for_rollout").(bool) {
 		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+		err := waitForDeploymentRollout(ctx, conn, out.GetNamespace(), out.GetName(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	return resourceKubernetesDeploymentV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesDeploymentV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesDeploymentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -449,47 +326,65 @@ func GetDeploymentCondition(status appsv1.DeploymentStatus, condType appsv1.Depl
 	return nil
 }
 
-func waitForDeploymentReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) retry.RetryFunc {
-	return func() *retry.RetryError {
-		// Query the deployment to get a status update.
-		dply, err := conn.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+// waitForDeploymentRollout watches the deployment until its rollout is
+// complete, the progress deadline is exceeded or the timeout expires.
+func waitForDeploymentRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.AppsV1().Deployments(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.AppsV1().Deployments(ns).Watch(ctx, options)
+		})
+	obj, err := waitForRollout(ctx, lw, &appsv1.Deployment{}, fmt.Sprintf("Deployment %s/%s", ns, name), timeout, func(obj runtime.Object) (bool, string, error) {
+		return deploymentRolloutStatus(obj.(*appsv1.Deployment))
+	})
+	if err == nil {
+		return nil
+	}
+	if dply, ok := obj.(*appsv1.Deployment); ok {
+		return withPodProblems(ctx, conn, ns, dply.Spec.Selector, err)
+	}
+	return err
+}
 
-		var specReplicas int32 = 1 // default, according to API docs
-		if dply.Spec.Replicas != nil {
-			specReplicas = *dply.Spec.Replicas
-		}
+// deploymentRolloutStatus reports whether the rollout of the deployment is
+// complete and, if it is not, what it is still waiting for. An error is
+// returned when the rollout cannot complete without further changes.
+func deploymentRolloutStatus(dply *appsv1.Deployment) (bool, string, error) {
+	var specReplicas int32 = 1 // default, according to API docs
+	if dply.Spec.Replicas != nil {
+		specReplicas = *dply.Spec.Replicas
+	}
 
-		if dply.Generation > dply.Status.ObservedGeneration {
-			return retry.RetryableError(fmt.Errorf("Waiting for rollout to start"))
-		}
+	if dply.Generation > dply.Status.ObservedGeneration {
+		return false, "Waiting for rollout to start", nil
+	}
 
-		if dply.Generation == dply.Status.ObservedGeneration {
-			cond := GetDeploymentCondition(dply.Status, appsv1.DeploymentProgressing)
-			if cond != nil && cond.Reason == TimedOutReason {
-				return retry.NonRetryableError(fmt.Errorf("Deployment exceeded its progress deadline"))
-			}
+	if dply.Generation < dply.Status.ObservedGeneration {
+		return false, "", fmt.Errorf("Observed generation %d is not expected to be greater than generation %d", dply.Status.ObservedGeneration, dply.Generation)
+	}
 
-			if dply.Status.UpdatedReplicas < specReplicas {
-				return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", dply.Status.UpdatedReplicas, specReplicas))
-			}
+	cond := GetDeploymentCondition(dply.Status, appsv1.DeploymentProgressing)
+	if cond != nil && cond.Reason == TimedOutReason {
+		return false, "", fmt.Errorf("Deployment %s/%s exceeded its progress deadline: %s", dply.Namespace, dply.Name, cond.Message)
+	}
 
-			if dply.Status.Replicas > dply.Status.UpdatedReplicas {
-				return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d old replicas are pending termination...", dply.Status.Replicas-dply.Status.UpdatedReplicas))
-			}
+	if dply.Status.UpdatedReplicas < specReplicas {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", dply.Status.UpdatedReplicas, specReplicas), nil
+	}
 
-			if dply.Status.Replicas > dply.Status.ReadyReplicas {
-				return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d replicas wanted; %d replicas Ready", dply.Status.Replicas, dply.Status.ReadyReplicas))
-			}
+	if dply.Status.Replicas > dply.Status.UpdatedReplicas {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", dply.Status.Replicas-dply.Status.UpdatedReplicas), nil
+	}
 
-			if dply.Status.AvailableReplicas < dply.Status.UpdatedReplicas {
-				return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d of %d updated replicas are available...", dply.Status.AvailableReplicas, dply.Status.UpdatedReplicas))
-			}
-			return nil
-		}
+	if dply.Status.Replicas > dply.Status.ReadyReplicas {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d replicas wanted; %d replicas Ready", dply.Status.Replicas, dply.Status.ReadyReplicas), nil
+	}
 
-		return retry.NonRetryableError(fmt.Errorf("Observed generation %d is not expected to be greater than generation %d", dply.Status.ObservedGeneration, dply.Generation))
+	if dply.Status.AvailableReplicas < specReplicas {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", dply.Status.AvailableReplicas, specReplicas), nil
 	}
+
+	return true, "", nil
 }
@@ -15,6 +15,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestAccKubernetesDeploymentV1_minimal(t *testing.T) {
@@ -1255,6 +1256,22 @@ func TestAccKubernetesDeploymentV1_with_restart_policy(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeploymentV1_rollout_stalled(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDeploymentV1Config_rolloutStalled(name, "registry.invalid/tf-acc-test:missing"),
+				ExpectError: regexp.MustCompile(`(?s)Timed out waiting for Deployment .* to roll out.*(ErrImagePull|ImagePullBackOff)`),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesDeploymentForceNew(old, new *appsv1.Deployment, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -3150,3 +3167,108 @@ func testAccKubernetesDeploymentV1ConfigWithResourceFieldSelector(rcName, imageN
 }
 `, rcName, imageName, resourceName, divisor)
 }
+
+func testAccKubernetesDeploymentV1Config_rolloutStalled(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+  timeouts {
+    create = "1m"
+  }
+}
+`, name, imageName)
+}
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	cases := map[string]struct {
+		Deployment appsv1.Deployment
+		Done       bool
+		Status     string
+		Error      bool
+	}{
+		"rollout not started": {
+			Deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			Status: "Waiting for rollout to start",
+		},
+		"replicas not updated": {
+			Deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 1},
+			},
+			Status: "Waiting for rollout to finish: 1 out of 2 new replicas have been updated...",
+		},
+		"replicas not available": {
+			Deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 1},
+			},
+			Status: "Waiting for rollout to finish: 1 of 2 updated replicas are available...",
+		},
+		"progress deadline exceeded": {
+			Deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           2,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Reason: TimedOutReason},
+					},
+				},
+			},
+			Error: true,
+		},
+		"complete": {
+			Deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2},
+			},
+			Done: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, status, err := deploymentRolloutStatus(&tc.Deployment)
+			if (err != nil) != tc.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != tc.Done {
+				t.Fatalf("expected done to be %t, got %t", tc.Done, done)
+			}
+			if status != tc.Status {
+				t.Fatalf("expected status %q, got %q", tc.Status, status)
+			}
+		})
+	}
+}
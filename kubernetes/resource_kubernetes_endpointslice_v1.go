@@ -24,6 +24,9 @@ func resourceKubernetesEndpointSliceV1() *schema.Resource {
 		ReadContext:   resourceKubernetesEndpointSliceV1Read,
 		UpdateContext: resourceKubernetesEndpointSliceV1Update,
 		DeleteContext: resourceKubernetesEndpointSliceV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("endpoint_slice", true),
@@ -40,6 +40,12 @@ func TestAccKubernetesEndpointSliceV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "address_type", "IPv4"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
 			{
 				Config: testAccKubernetesEndpointSliceV1Config_modified(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -301,7 +301,7 @@ func resourceKubernetesEnvRead(ctx context.Context, d *schema.ResourceData, m in
 
 	// strip out envs not managed by Terraform
 	fieldManagerName := d.Get("field_manager").(string)
-	managedEnvs, err := getManagedEnvs(res.GetManagedFields(), fieldManagerName, d, res)
+	managedEnvs, err := getManagedEnvs(res.GetManagedFields(), fieldManagerName, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -344,20 +344,29 @@ func getResponseEnvs(u *unstructured.Unstructured, containerName string, kind st
 	containers = append(containers, initContainers...)
 
 	for _, c := range containers {
-		container := c.(map[string]interface{})
-		if container["name"].(string) == containerName {
-			return container["env"].([]interface{}), nil
+		container, ok := c.(map[string]interface{})
+		if !ok || container["name"] != containerName {
+			continue
 		}
+		// a container without environment variables has no env field at all
+		env, _ := container["env"].([]interface{})
+		return env, nil
 	}
 	return nil, fmt.Errorf("could not find container with name %q", containerName)
 }
 
 // getManagedEnvs reads the field manager metadata to discover which environment variables we're managing
-func getManagedEnvs(managedFields []v1.ManagedFieldsEntry, manager string, d *schema.ResourceData, u *unstructured.Unstructured) (map[string]interface{}, error) {
+func getManagedEnvs(managedFields []v1.ManagedFieldsEntry, manager string, d *schema.ResourceData) (map[string]interface{}, error) {
 	var envs map[string]interface{}
 	kind := d.Get("kind").(string)
+	fieldManagerKey := "f:containers"
+	containerName := d.Get("container").(string)
+	if v := d.Get("init_container").(string); v != "" {
+		containerName = v
+		fieldManagerKey = "f:initContainers"
+	}
 	for _, m := range managedFields {
-		if m.Manager != manager {
+		if m.Manager != manager || m.FieldsV1 == nil {
 			continue
 		}
 		var mm map[string]interface{}
@@ -366,24 +375,15 @@ func getManagedEnvs(managedFields []v1.ManagedFieldsEntry, manager string, d *sc
 			return nil, err
 		}
 
-		spec, _, err := unstructured.NestedMap(u.Object, "f:spec", "f:template", "f:spec")
+		path := []string{"f:spec", "f:template", "f:spec", fieldManagerKey, fmt.Sprintf(`k:{"name":%q}`, containerName), "f:env"}
 		if kind == "CronJob" {
-			spec, _, err = unstructured.NestedMap(u.Object, "f:spec", "f:jobTemplate", "f:spec", "f:template", "f:spec")
+			path = append([]string{"f:spec", "f:jobTemplate"}, path...)
 		}
-		if err == nil {
+		e, found, err := unstructured.NestedMap(mm, path...)
+		if err != nil {
 			return nil, err
 		}
-
-		fieldManagerKey := "f:containers"
-		containerName := d.Get("container").(string)
-		if v := d.Get("init_container").(string); v != "" {
-			containerName = v
-			fieldManagerKey = "f:initContainers"
-		}
-		containers := spec[fieldManagerKey].(map[string]interface{})
-		containerKey := fmt.Sprintf(`k:{"name":%q}`, containerName)
-		k := containers[containerKey].(map[string]interface{})
-		if e, ok := k["f:env"].(map[string]interface{}); ok {
+		if found {
 			envs = e
 		}
 	}
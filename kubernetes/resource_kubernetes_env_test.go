@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -252,6 +253,60 @@ func TestAccKubernetesEnv_CronJob_initContainer(t *testing.T) {
 	})
 }
 
+func TestGetManagedEnvs(t *testing.T) {
+	managedFields := []metav1.ManagedFieldsEntry{
+		{
+			Manager: "kubectl",
+			FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{"f:env":{"k:{\"name\":\"NOT_OURS\"}":{}}}}}}}}`),
+			},
+		},
+		{
+			Manager: defaultFieldManagerName,
+			FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{"f:env":{"k:{\"name\":\"OURS\"}":{}}}},"f:initContainers":{"k:{\"name\":\"init\"}":{"f:env":{"k:{\"name\":\"INIT\"}":{}}}}}}}}`),
+			},
+		},
+	}
+	cases := map[string]struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		"container": {
+			raw:      map[string]interface{}{"kind": "Deployment", "container": "nginx"},
+			expected: `k:{"name":"OURS"}`,
+		},
+		"init container": {
+			raw:      map[string]interface{}{"kind": "Deployment", "init_container": "init"},
+			expected: `k:{"name":"INIT"}`,
+		},
+		"unmanaged container": {
+			raw: map[string]interface{}{"kind": "Deployment", "container": "sidecar"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesEnv().Schema, tc.raw)
+			envs, err := getManagedEnvs(managedFields, defaultFieldManagerName, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.expected == "" {
+				if len(envs) != 0 {
+					t.Fatalf("expected no managed envs, got %v", envs)
+				}
+				return
+			}
+			if len(envs) != 1 {
+				t.Fatalf("expected exactly one managed env, got %v", envs)
+			}
+			if _, ok := envs[tc.expected]; !ok {
+				t.Fatalf("expected %s to be managed, got %v", tc.expected, envs)
+			}
+		})
+	}
+}
+
 func createInitContainerEnv(t *testing.T, name, namespace string) error {
 	conn, err := testAccProvider.Meta().(providerMetadata).MainClientset()
 	if err != nil {
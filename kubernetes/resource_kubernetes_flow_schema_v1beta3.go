@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesFlowSchemaV1Beta3() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A flow schema defines the schema of a group of flows and assigns the requests that match it to a priority level. It is part of API Priority and Fairness.",
+		CreateContext: resourceKubernetesFlowSchemaV1Beta3Create,
+		ReadContext:   resourceKubernetesFlowSchemaV1Beta3Read,
+		UpdateContext: resourceKubernetesFlowSchemaV1Beta3Update,
+		DeleteContext: resourceKubernetesFlowSchemaV1Beta3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("flow schema", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec is the specification of the desired behavior of a flow schema.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority_level_configuration": {
+							Type:        schema.TypeList,
+							Description: "A reference to the priority level configuration that requests matching this flow schema are assigned to.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Description:  "Name of the priority level configuration being referenced.",
+										Required:     true,
+										ValidateFunc: validateName,
+									},
+								},
+							},
+						},
+						"matching_precedence": {
+							Type:         schema.TypeInt,
+							Description:  "Used to choose among the flow schemas that match a given request. The chosen flow schema is among those with the numerically lowest matching precedence. Must be between 1 and 10000, inclusive. Defaults to 1000.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 10000),
+						},
+						"distinguisher_method": {
+							Type:        schema.TypeList,
+							Description: "Defines how to compute the flow distinguisher for requests that match this schema. When omitted, all requests matching this schema are considered part of the same flow.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "The type of flow distinguisher method. Supported types are `ByUser` and `ByNamespace`.",
+										Required:    true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(flowcontrolv1beta3.FlowDistinguisherMethodByUserType),
+											string(flowcontrolv1beta3.FlowDistinguisherMethodByNamespaceType),
+										}, false),
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Description: "Describes which requests will match this flow schema. A request matches if and only if at least one rule matches it. When omitted, no requests will match the flow schema.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: flowSchemaV1Beta3RuleFields(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowSchemaV1Beta3RuleFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"subject": {
+			Type:        schema.TypeList,
+			Description: "The normal users, service accounts and groups that this rule cares about.",
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:        schema.TypeString,
+						Description: "Indicates which one of the other fields is set. Supported values are `User`, `Group` and `ServiceAccount`.",
+						Required:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(flowcontrolv1beta3.SubjectKindUser),
+							string(flowcontrolv1beta3.SubjectKindGroup),
+							string(flowcontrolv1beta3.SubjectKindServiceAccount),
+						}, false),
+					},
+					"user": {
+						Type:        schema.TypeList,
+						Description: "Matches based on the username.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The username that matches, or `*` to match all usernames.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"group": {
+						Type:        schema.TypeList,
+						Description: "Matches based on the user group name.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The user group that matches, or `*` to match all user groups.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"service_account": {
+						Type:        schema.TypeList,
+						Description: "Matches service accounts.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"namespace": {
+									Type:        schema.TypeString,
+									Description: "The namespace of the matching service account.",
+									Required:    true,
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The name of the matching service account, or `*` to match regardless of name.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource_rule": {
+			Type:        schema.TypeList,
+			Description: "The resource requests that match this rule.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"verbs": {
+						Type:        schema.TypeList,
+						Description: "A list of matching verbs. `*` matches all verbs.",
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"api_groups": {
+						Type:        schema.TypeList,
+						Description: "A list of matching API groups. `*` matches all API groups.",
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"resources": {
+						Type:        schema.TypeList,
+						Description: "A list of matching resources, and optionally subresources in the form `resource/subresource`. `*` matches all resources.",
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"cluster_scope": {
+						Type:        schema.TypeBool,
+						Description: "Indicates whether to match requests that do not specify a namespace.",
+						Optional:    true,
+					},
+					"namespaces": {
+						Type:        schema.TypeList,
+						Description: "A list of target namespaces that restricts matches. `*` matches any specified namespace.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"non_resource_rule": {
+			Type:        schema.TypeList,
+			Description: "The non-resource requests that match this rule.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"verbs": {
+						Type:        schema.TypeList,
+						Description: "A list of matching verbs. `*` matches all verbs.",
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"non_resource_urls": {
+						Type:        schema.TypeList,
+						Description: "A set of URL prefixes that a user should have access to, for example `/healthz`. `*` matches all non-resource URLs.",
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesFlowSchemaV1Beta3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fs := flowcontrolv1beta3.FlowSchema{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandFlowSchemaV1Beta3Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new flow schema: %#v", fs)
+	out, err := conn.FlowcontrolV1beta3().FlowSchemas().Create(ctx, &fs, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create flow schema: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted new flow schema: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesFlowSchemaV1Beta3Read(ctx, d, meta)
+}
+
+func resourceKubernetesFlowSchemaV1Beta3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesFlowSchemaV1Beta3Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading flow schema %s", name)
+	fs, err := conn.FlowcontrolV1beta3().FlowSchemas().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received flow schema: %#v", fs)
+
+	err = d.Set("metadata", flattenMetadata(fs.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenFlowSchemaV1Beta3Spec(fs.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesFlowSchemaV1Beta3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandFlowSchemaV1Beta3Spec(d.Get("spec").([]interface{})),
+		})
+	}
+
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating flow schema %q: %v", name, string(data))
+	out, err := conn.FlowcontrolV1beta3().FlowSchemas().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update flow schema: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated flow schema: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesFlowSchemaV1Beta3Read(ctx, d, meta)
+}
+
+func resourceKubernetesFlowSchemaV1Beta3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting flow schema: %#v", name)
+	err = conn.FlowcontrolV1beta3().FlowSchemas().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Flow schema %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesFlowSchemaV1Beta3Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking flow schema %s", name)
+	_, err = conn.FlowcontrolV1beta3().FlowSchemas().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+
+	return true, err
+}
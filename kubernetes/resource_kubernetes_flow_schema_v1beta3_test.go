@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesFlowSchemaV1Beta3_basic(t *testing.T) {
+	var conf flowcontrolv1beta3.FlowSchema
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_flow_schema_v1beta3.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.26.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesFlowSchemaV1Beta3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesFlowSchemaV1Beta3Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesFlowSchemaV1Beta3Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority_level_configuration.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.matching_precedence", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.distinguisher_method.0.type", "ByUser"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.kind", "Group"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.group.0.name", "system:authenticated"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.resource_rule.0.verbs.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.resource_rule.0.resources.0", "configmaps"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.resource_rule.0.namespaces.0", "*"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesFlowSchemaV1Beta3Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesFlowSchemaV1Beta3Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.matching_precedence", "500"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.distinguisher_method.0.type", "ByNamespace"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.kind", "ServiceAccount"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.service_account.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.service_account.0.name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.non_resource_rule.0.verbs.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.non_resource_rule.0.non_resource_urls.0", "/healthz"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesFlowSchemaV1Beta3Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_flow_schema_v1beta3" {
+			continue
+		}
+
+		resp, err := conn.FlowcontrolV1beta3().FlowSchemas().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Flow schema still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesFlowSchemaV1Beta3Exists(n string, obj *flowcontrolv1beta3.FlowSchema) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out, err := conn.FlowcontrolV1beta3().FlowSchemas().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesFlowSchemaV1Beta3Config_priorityLevel(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1beta3" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      limit_response {
+        type = "Reject"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesFlowSchemaV1Beta3Config_basic(name string) string {
+	return testAccKubernetesFlowSchemaV1Beta3Config_priorityLevel(name) + fmt.Sprintf(`resource "kubernetes_flow_schema_v1beta3" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    priority_level_configuration {
+      name = kubernetes_priority_level_configuration_v1beta3.test.metadata.0.name
+    }
+    distinguisher_method {
+      type = "ByUser"
+    }
+    rule {
+      subject {
+        kind = "Group"
+        group {
+          name = "system:authenticated"
+        }
+      }
+      resource_rule {
+        verbs      = ["get"]
+        api_groups = [""]
+        resources  = ["configmaps"]
+        namespaces = ["*"]
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesFlowSchemaV1Beta3Config_modified(name string) string {
+	return testAccKubernetesFlowSchemaV1Beta3Config_priorityLevel(name) + fmt.Sprintf(`resource "kubernetes_flow_schema_v1beta3" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    priority_level_configuration {
+      name = kubernetes_priority_level_configuration_v1beta3.test.metadata.0.name
+    }
+    matching_precedence = 500
+    distinguisher_method {
+      type = "ByNamespace"
+    }
+    rule {
+      subject {
+        kind = "ServiceAccount"
+        service_account {
+          namespace = "default"
+          name      = "*"
+        }
+      }
+      non_resource_rule {
+        verbs             = ["get"]
+        non_resource_urls = ["/healthz"]
+      }
+    }
+  }
+}
+`, name)
+}
@@ -11,9 +11,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
@@ -31,14 +35,21 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 }
 
 func resourceKubernetesHorizontalPodAutoscalerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if useV2Beta2(d) {
-		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Create(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
+	gv, err := horizontalPodAutoscalerGroupVersion(conn, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	switch gv {
+	case autoscalingv2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Create(ctx, d, meta)
+	case autoscalingv2beta2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Create(ctx, d, meta)
+	}
 
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	spec, err := expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{}))
@@ -71,14 +82,21 @@ func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schem
 		d.SetId("")
 		return diag.Diagnostics{}
 	}
-	if useV2Beta2(d) {
-		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Read(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
+	gv, err := horizontalPodAutoscalerGroupVersion(conn, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	switch gv {
+	case autoscalingv2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
+	case autoscalingv2beta2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Read(ctx, d, meta)
+	}
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -93,6 +111,13 @@ func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schem
 
 	// NOTE: this is needed for import
 	if _, exists := hpa.ObjectMeta.GetAnnotations()["autoscaling.alpha.kubernetes.io/metrics"]; exists {
+		v2, err := useAutoscalingV2(conn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if v2 {
+			return resourceKubernetesHorizontalPodAutoscalerV2Read(ctx, d, meta)
+		}
 		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Read(ctx, d, meta)
 	}
 
@@ -113,14 +138,21 @@ func resourceKubernetesHorizontalPodAutoscalerRead(ctx context.Context, d *schem
 }
 
 func resourceKubernetesHorizontalPodAutoscalerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if useV2Beta2(d) {
-		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Update(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
+	gv, err := horizontalPodAutoscalerGroupVersion(conn, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	switch gv {
+	case autoscalingv2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Update(ctx, d, meta)
+	case autoscalingv2beta2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Update(ctx, d, meta)
+	}
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -148,14 +180,21 @@ func resourceKubernetesHorizontalPodAutoscalerUpdate(ctx context.Context, d *sch
 }
 
 func resourceKubernetesHorizontalPodAutoscalerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if useV2Beta2(d) {
-		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Delete(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
+	gv, err := horizontalPodAutoscalerGroupVersion(conn, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	switch gv {
+	case autoscalingv2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Delete(ctx, d, meta)
+	case autoscalingv2beta2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Delete(ctx, d, meta)
+	}
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -177,14 +216,21 @@ func resourceKubernetesHorizontalPodAutoscalerDelete(ctx context.Context, d *sch
 }
 
 func resourceKubernetesHorizontalPodAutoscalerExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
-	if useV2Beta2(d) {
-		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Exists(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
 	}
 
-	conn, err := meta.(KubeClientsets).MainClientset()
+	gv, err := horizontalPodAutoscalerGroupVersion(conn, d)
 	if err != nil {
 		return false, err
 	}
+	switch gv {
+	case autoscalingv2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Exists(ctx, d, meta)
+	case autoscalingv2beta2.SchemeGroupVersion:
+		return resourceKubernetesHorizontalPodAutoscalerV2Beta2Exists(ctx, d, meta)
+	}
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -202,14 +248,32 @@ func resourceKubernetesHorizontalPodAutoscalerExists(ctx context.Context, d *sch
 	return true, err
 }
 
-func useV2Beta2(d *schema.ResourceData) bool {
+// horizontalPodAutoscalerGroupVersion returns the autoscaling API version used
+// for the resource. autoscaling/v1 only supports CPU utilization, so a resource
+// with a metric or behavior field uses autoscaling/v2, or autoscaling/v2beta2
+// on servers that do not serve autoscaling/v2 yet.
+func horizontalPodAutoscalerGroupVersion(conn *kubernetes.Clientset, d *schema.ResourceData) (apimachineryschema.GroupVersion, error) {
+	if !useV2Metrics(d) {
+		return autoscalingv1.SchemeGroupVersion, nil
+	}
+	v2, err := useAutoscalingV2(conn)
+	if err != nil {
+		return apimachineryschema.GroupVersion{}, err
+	}
+	if v2 {
+		return autoscalingv2.SchemeGroupVersion, nil
+	}
+	return autoscalingv2beta2.SchemeGroupVersion, nil
+}
+
+func useV2Metrics(d *schema.ResourceData) bool {
 	if len(d.Get("spec.0.metric").([]interface{})) > 0 {
-		log.Printf("[INFO] Using autoscaling/v2beta2 because this resource has a metric field")
+		log.Printf("[INFO] Not using autoscaling/v1 because this resource has a metric field")
 		return true
 	}
 
 	if len(d.Get("spec.0.behavior").([]interface{})) > 0 {
-		log.Printf("[INFO] Using autoscaling/v2beta2 because this resource has a behavior field")
+		log.Printf("[INFO] Not using autoscaling/v1 because this resource has a behavior field")
 		return true
 	}
 
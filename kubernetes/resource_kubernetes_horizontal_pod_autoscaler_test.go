@@ -134,6 +134,46 @@ func TestAccKubernetesHorizontalPodAutoscaler_generatedName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesHorizontalPodAutoscaler_v2Metrics(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_horizontal_pod_autoscaler.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.23.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesHorizontalPodAutoscalerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerConfig_v2Metrics(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.max_replicas", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.type", "Resource"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.resource.0.name", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.resource.0.target.0.average_utilization", "75"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.stabilization_window_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.policy.0.type", "Pods"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesHorizontalPodAutoscalerDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -283,3 +323,46 @@ func testAccKubernetesHorizontalPodAutoscalerConfig_generatedName(prefix string)
 }
 `, prefix)
 }
+
+func testAccKubernetesHorizontalPodAutoscalerConfig_v2Metrics(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_horizontal_pod_autoscaler" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    max_replicas = 10
+
+    scale_target_ref {
+      api_version = "apps/v1"
+      kind        = "Deployment"
+      name        = "TerraformAccTest"
+    }
+
+    metric {
+      type = "Resource"
+      resource {
+        name = "cpu"
+        target {
+          type                = "Utilization"
+          average_utilization = "75"
+        }
+      }
+    }
+
+    behavior {
+      scale_down {
+        stabilization_window_seconds = 300
+        select_policy                = "Min"
+
+        policy {
+          period_seconds = 120
+          type           = "Pods"
+          value          = 1
+        }
+      }
+    }
+  }
+}
+`, name)
+}
@@ -25,6 +25,7 @@ func resourceKubernetesIngressClassV1() *schema.Resource {
 		ReadContext:   resourceKubernetesIngressClassV1Read,
 		UpdateContext: resourceKubernetesIngressClassV1Update,
 		DeleteContext: resourceKubernetesIngressClassV1Delete,
+		CustomizeDiff: resourceKubernetesIngressClassV1CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -39,6 +40,12 @@ func resourceKubernetesIngressClassV1Schema() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
 		"metadata": metadataSchema("ingress_class_v1", true),
+		"is_default_class": {
+			Type:        schema.TypeBool,
+			Description: fmt.Sprintf("Marks this IngressClass as the default for the cluster by setting the `%s` annotation. Ingresses that do not specify a class are assigned the default one.", networking.AnnotationIsDefaultIngressClass),
+			Optional:    true,
+			Default:     false,
+		},
 		"spec": {
 			Type:        schema.TypeList,
 			Description: docIngressClass["spec"],
@@ -49,12 +56,13 @@ func resourceKubernetesIngressClassV1Schema() map[string]*schema.Schema {
 					"controller": {
 						Type:        schema.TypeString,
 						Description: docIngressClassSpec["controller"],
-						Optional:    true,
+						Required:    true,
 					},
 					"parameters": {
 						Type:        schema.TypeList,
 						Description: docIngressClass["parameters"],
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"api_group": {
@@ -99,7 +107,7 @@ func resourceKubernetesIngressClassV1Create(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandIngressClassV1Metadata(d)
 	ing := &networking.IngressClass{
 		Spec: expandIngressClassV1Spec(d.Get("spec").([]interface{})),
 	}
@@ -112,7 +120,7 @@ func resourceKubernetesIngressClassV1Create(ctx context.Context, d *schema.Resou
 	log.Printf("[INFO] Submitted new IngressClass: %#v", out)
 	d.SetId(out.ObjectMeta.GetName())
 
-	return diag.Diagnostics{}
+	return resourceKubernetesIngressClassV1Read(ctx, d, meta)
 }
 
 func resourceKubernetesIngressClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,6 +146,14 @@ func resourceKubernetesIngressClassV1Read(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Failed to read Ingress Class '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
 	log.Printf("[INFO] Received Ingress Class: %#v", ing)
+	// The annotation may also be managed directly through metadata.annotations.
+	annotations := d.Get("metadata.0.annotations").(map[string]interface{})
+	isDefault := ing.Annotations[networking.AnnotationIsDefaultIngressClass] == "true" && !isKeyInMap(networking.AnnotationIsDefaultIngressClass, annotations)
+	err = d.Set("is_default_class", isDefault)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
@@ -159,7 +175,7 @@ func resourceKubernetesIngressClassV1Update(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandIngressClassV1Metadata(d)
 	spec := expandIngressClassV1Spec(d.Get("spec").([]interface{}))
 
 	if metadata.Namespace == "" {
@@ -235,6 +251,43 @@ func resourceKubernetesIngressClassV1Exists(ctx context.Context, d *schema.Resou
 	return true, err
 }
 
+// resourceKubernetesIngressClassV1CustomizeDiff rejects marking the IngressClass
+// as default when another IngressClass in the cluster already is. The check is
+// skipped when the cluster cannot be reached during planning.
+func resourceKubernetesIngressClassV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("is_default_class").(bool) || (diff.Id() != "" && !diff.HasChange("is_default_class")) {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		log.Printf("[DEBUG] Skipping default Ingress Class check: %s", err)
+		return nil
+	}
+	list, err := conn.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Skipping default Ingress Class check: %s", err)
+		return nil
+	}
+	name := diff.Get("metadata.0.name").(string)
+	for _, ic := range list.Items {
+		if ic.Name != name && ic.Annotations[networking.AnnotationIsDefaultIngressClass] == "true" {
+			return fmt.Errorf("Ingress Class %q is already marked as default, only one Ingress Class can set `is_default_class`", ic.Name)
+		}
+	}
+	return nil
+}
+
+func expandIngressClassV1Metadata(d *schema.ResourceData) metav1.ObjectMeta {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if d.Get("is_default_class").(bool) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[networking.AnnotationIsDefaultIngressClass] = "true"
+	}
+	return metadata
+}
+
 func expandIngressClassV1Spec(l []interface{}) networking.IngressClassSpec {
 	if len(l) == 0 || l[0] == nil {
 		return networking.IngressClassSpec{}
@@ -149,6 +149,57 @@ func TestAccKubernetesIngressClassV1_parameters_apiGroup(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesIngressClassV1_isDefaultClass(t *testing.T) {
+	var conf networking.IngressClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_ingress_class_v1.test"
+
+	// Marking an IngressClass as default affects every Ingress created without
+	// a class, so this test runs sequentially.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressClassV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressClassV1ConfigIsDefaultClass(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "is_default_class", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "0"),
+					func(s *terraform.State) error {
+						if conf.Annotations[networking.AnnotationIsDefaultIngressClass] != "true" {
+							return fmt.Errorf("expected annotation %q to be set", networking.AnnotationIsDefaultIngressClass)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesIngressClassV1ConfigIsDefaultClass(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "is_default_class", "false"),
+					func(s *terraform.State) error {
+						if _, ok := conf.Annotations[networking.AnnotationIsDefaultIngressClass]; ok {
+							return fmt.Errorf("expected annotation %q to be removed", networking.AnnotationIsDefaultIngressClass)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesIngressClassV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -241,3 +292,16 @@ func testAccKubernetesIngressClassV1ConfigParametersApiGroup(name, paramName str
 }
 `, name, paramName)
 }
+
+func testAccKubernetesIngressClassV1ConfigIsDefaultClass(name string, isDefault bool) string {
+	return fmt.Sprintf(`resource "kubernetes_ingress_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+  is_default_class = %t
+  spec {
+    controller = "example.com/ingress-controller"
+  }
+}
+`, name, isDefault)
+}
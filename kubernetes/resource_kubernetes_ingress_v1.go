@@ -29,7 +29,8 @@ func resourceKubernetesIngressV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: resourceKubernetesIngressV1Schema(),
+		CustomizeDiff: resourceKubernetesIngressV1CustomizeDiff,
+		Schema:        resourceKubernetesIngressV1Schema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
@@ -121,7 +122,10 @@ func resourceKubernetesIngressV1Schema() map[string]*schema.Schema {
 									Type:        schema.TypeList,
 									Description: docIngressTLS["hosts"],
 									Optional:    true,
-									Elem:        &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: validateWildcardHostname,
+									},
 								},
 								"secret_name": {
 									Type:        schema.TypeString,
@@ -174,6 +178,89 @@ func resourceKubernetesIngressV1Schema() map[string]*schema.Schema {
 	}
 }
 
+// resourceKubernetesIngressV1CustomizeDiff checks that the default backend and
+// the backends of the rule paths each set exactly one of service or resource.
+// When the provider validates referenced secrets, it also checks that the TLS
+// secrets exist.
+func resourceKubernetesIngressV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if m, ok := meta.(providerMetadata); ok && m.ValidateReferencedSecrets {
+		if err := validateIngressV1TLSSecrets(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+	if err := validateIngressV1Backend(d, "spec.0.default_backend"); err != nil {
+		return err
+	}
+	rules, _ := d.Get("spec.0.rule.#").(int)
+	for i := 0; i < rules; i++ {
+		paths, _ := d.Get(fmt.Sprintf("spec.0.rule.%d.http.0.path.#", i)).(int)
+		for j := 0; j < paths; j++ {
+			if err := validateIngressV1Backend(d, fmt.Sprintf("spec.0.rule.%d.http.0.path.%d.backend", i, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateIngressV1Backend checks that the backend block at key, when present,
+// sets exactly one of service or resource. Blocks whose number is unknown at
+// plan time are skipped.
+func validateIngressV1Backend(d *schema.ResourceDiff, key string) error {
+	if n, _ := d.Get(key + ".#").(int); n == 0 {
+		return nil
+	}
+	serviceKey := key + ".0.service"
+	resourceKey := key + ".0.resource"
+	if !d.NewValueKnown(serviceKey+".#") || !d.NewValueKnown(resourceKey+".#") {
+		return nil
+	}
+	services, _ := d.Get(serviceKey + ".#").(int)
+	resources, _ := d.Get(resourceKey + ".#").(int)
+	if services+resources != 1 {
+		return fmt.Errorf("exactly one of %s or %s must be set", serviceKey, resourceKey)
+	}
+	return nil
+}
+
+// validateIngressV1TLSSecrets checks that the secrets named by the TLS blocks
+// exist in the namespace of the ingress. Names unknown at plan time are skipped.
+func validateIngressV1TLSSecrets(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("metadata.0.namespace") {
+		return nil
+	}
+	namespace := d.Get("metadata.0.namespace").(string)
+	if namespace == "" {
+		namespace = "default"
+	}
+	n, _ := d.Get("spec.0.tls.#").(int)
+	if n == 0 {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("spec.0.tls.%d.secret_name", i)
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		name := d.Get(key).(string)
+		if name == "" {
+			continue
+		}
+		_, err := conn.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("%s: secret %q does not exist in namespace %q", key, name, namespace)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: failed to read secret %q in namespace %q: %s", key, name, namespace, err)
+		}
+	}
+	return nil
+}
+
 func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -185,16 +272,17 @@ func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceDa
 		Spec: expandIngressV1Spec(d.Get("spec").([]interface{})),
 	}
 	ing.ObjectMeta = metadata
+	diags := ingressV1TLSHostWarnings(ing.Spec)
 	log.Printf("[INFO] Creating new ingress: %#v", ing)
 	out, err := conn.NetworkingV1().Ingresses(metadata.Namespace).Create(ctx, ing, metav1.CreateOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to create Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
+		return append(diags, diag.Errorf("Failed to create Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)...)
 	}
 	log.Printf("[INFO] Submitted new ingress: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
 	if !d.Get("wait_for_load_balancer").(bool) {
-		return resourceKubernetesIngressV1Read(ctx, d, meta)
+		return append(diags, resourceKubernetesIngressV1Read(ctx, d, meta)...)
 	}
 
 	log.Printf("[INFO] Waiting for load balancer to become ready: %#v", out)
@@ -222,9 +310,9 @@ func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceDa
 		return retry.RetryableError(fmt.Errorf("Load Balancer is not ready yet"))
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
-	return diag.Diagnostics{}
+	return diags
 }
 
 func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -300,13 +388,18 @@ func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceDa
 		Spec:       spec,
 	}
 
+	var diags diag.Diagnostics
+	if d.HasChanges("spec.0.tls", "spec.0.rule", "spec.0.default_backend") {
+		diags = ingressV1TLSHostWarnings(spec)
+	}
+
 	out, err := conn.NetworkingV1().Ingresses(namespace).Update(ctx, ingress, metav1.UpdateOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update Ingress %s because: %s", buildId(ingress.ObjectMeta), err)
+		return append(diags, diag.Errorf("Failed to update Ingress %s because: %s", buildId(ingress.ObjectMeta), err)...)
 	}
 	log.Printf("[INFO] Submitted updated ingress: %#v", out)
 
-	return resourceKubernetesIngressV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesIngressV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesIngressV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
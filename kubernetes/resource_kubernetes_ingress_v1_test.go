@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,6 +17,64 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestResourceKubernetesIngressV1Backends(t *testing.T) {
+	serviceBackend := []interface{}{map[string]interface{}{
+		"name": "app",
+		"port": []interface{}{map[string]interface{}{"number": 80}},
+	}}
+	resourceBackend := []interface{}{map[string]interface{}{
+		"api_group": "k8s.example.com",
+		"kind":      "StorageBucket",
+		"name":      "static-assets",
+	}}
+	cases := map[string]struct {
+		defaultBackend map[string]interface{}
+		ruleBackend    map[string]interface{}
+		err            string
+	}{
+		"service":  {defaultBackend: map[string]interface{}{"service": serviceBackend}},
+		"resource": {ruleBackend: map[string]interface{}{"resource": resourceBackend}},
+		"default backend with both": {
+			defaultBackend: map[string]interface{}{"service": serviceBackend, "resource": resourceBackend},
+			err:            `exactly one of spec.0.default_backend.0.service or spec.0.default_backend.0.resource must be set`,
+		},
+		"rule backend with neither": {
+			ruleBackend: map[string]interface{}{},
+			err:         `exactly one of spec.0.rule.0.http.0.path.0.backend.0.service or spec.0.rule.0.http.0.path.0.backend.0.resource must be set`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := map[string]interface{}{}
+			if tc.defaultBackend != nil {
+				spec["default_backend"] = []interface{}{tc.defaultBackend}
+			}
+			if tc.ruleBackend != nil {
+				spec["rule"] = []interface{}{map[string]interface{}{
+					"http": []interface{}{map[string]interface{}{
+						"path": []interface{}{map[string]interface{}{
+							"path":    "/",
+							"backend": []interface{}{tc.ruleBackend},
+						}},
+					}},
+				}}
+			}
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec":     []interface{}{spec},
+			}
+			_, err := resourceKubernetesIngressV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err != "" && (err == nil || !regexp.MustCompile(regexp.QuoteMeta(tc.err)).MatchString(err.Error())) {
+				t.Fatalf("expected error %q, got: %v", tc.err, err)
+			}
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccKubernetesIngressV1_serviceBackend(t *testing.T) {
 	var conf networking.Ingress
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -17,7 +17,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -28,6 +30,7 @@ func resourceKubernetesJobV1() *schema.Resource {
 		ReadContext:   resourceKubernetesJobV1Read,
 		UpdateContext: resourceKubernetesJobV1Update,
 		DeleteContext: resourceKubernetesJobV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -61,10 +64,36 @@ func resourceKubernetesJobV1Schema() map[string]*schema.Schema {
 				Schema: jobSpecFields(false),
 			},
 		},
+		"lifecycle_hook": lifecycleHookSchema("a running pod of the job, so the job has to run long enough for them to complete. Hooks are not run for suspended jobs, and are skipped when the job completes before a running pod is found"),
 		"wait_for_completion": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  true,
+			Type:        schema.TypeBool,
+			Description: "Wait for the job to complete, i.e. for it to have a `Complete` or `Failed` condition, within the create or update timeout. The apply fails if the job fails, e.g. because its `backoff_limit` or `active_deadline_seconds` is exceeded. Suspended jobs are not waited for. Defaults to true.",
+			Optional:    true,
+			Default:     true,
+		},
+		"status": {
+			Type:        schema.TypeList,
+			Description: "The most recently observed status of the job.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"active": {
+						Type:        schema.TypeInt,
+						Description: "The number of pending and running pods.",
+						Computed:    true,
+					},
+					"succeeded": {
+						Type:        schema.TypeInt,
+						Description: "The number of pods which reached phase Succeeded.",
+						Computed:    true,
+					},
+					"failed": {
+						Type:        schema.TypeInt,
+						Description: "The number of pods which reached phase Failed.",
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -80,6 +109,9 @@ func resourceKubernetesJobV1Create(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
 
 	job := batchv1.Job{
 		ObjectMeta: metadata,
@@ -100,12 +132,29 @@ func resourceKubernetesJobV1Create(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if d.Get("wait_for_completion").(bool) {
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilJobV1IsFinished(ctx, conn, namespace, name))
+	// A suspended job does not run any pods, so neither hooks can be run in
+	// one nor would it ever complete. A job that completes before any of its
+	// pods is seen running skips the hooks instead of being rolled back.
+	if !d.Get("spec.0.suspend").(bool) {
+		hooks := expandLifecycleHooks(d.Get("lifecycle_hook").([]interface{}), lifecycleHookEventCreate)
+		findPod := runningPodBySelector(conn, namespace, out.Spec.Selector, jobV1Finished(conn, namespace, name))
+		if err := runLifecycleHooks(ctx, meta, namespace, findPod, hooks); err != nil {
+			return rollBackCreate(ctx, d, meta, err, resourceKubernetesJobV1Delete)
+		}
+	}
+	if d.Get("wait_for_completion").(bool) && !d.Get("spec.0.suspend").(bool) {
+		job, err := waitForJobV1Completion(ctx, conn, namespace, name, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		// The job is not read again, as it may be cleaned up right after
+		// finishing because of ttl_seconds_after_finished.
+		if job != nil {
+			err = d.Set("status", flattenJobV1Status(job.Status))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		return diag.Diagnostics{}
 	}
 
@@ -159,6 +208,11 @@ func resourceKubernetesJobV1Read(ctx context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	err = d.Set("status", flattenJobV1Status(job.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	return diag.Diagnostics{}
 }
 
@@ -195,9 +249,20 @@ func resourceKubernetesJobV1Update(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	if d.Get("wait_for_completion").(bool) {
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			retryUntilJobV1IsFinished(ctx, conn, namespace, name))
+	// Suspending or resuming a job is patched separately, as spec.suspend
+	// may not be set on the job, which a JSON patch replace requires.
+	if d.HasChange("spec.0.suspend") {
+		patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, d.Get("spec.0.suspend").(bool)))
+		log.Printf("[INFO] Patching job %s: %s", d.Id(), patch)
+		out, err = conn.BatchV1().Jobs(namespace).Patch(ctx, name, pkgApi.StrategicMergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update Job! API error: %s", err)
+		}
+		log.Printf("[INFO] Submitted patched job: %#v", out)
+	}
+
+	if d.Get("wait_for_completion").(bool) && !d.Get("spec.0.suspend").(bool) {
+		_, err := waitForJobV1Completion(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -269,29 +334,67 @@ func resourceKubernetesJobV1Exists(ctx context.Context, d *schema.ResourceData,
 	return true, err
 }
 
-// retryUntilJobV1IsFinished checks if a given job has finished its execution in either a Complete or Failed state
-func retryUntilJobV1IsFinished(ctx context.Context, conn *kubernetes.Clientset, ns, name string) retry.RetryFunc {
-	return func() *retry.RetryError {
+// waitForJobV1Completion watches the job until it has finished in either a
+// Complete or a Failed state and returns the finished job. No job is returned
+// when it was deleted while waiting, which is expected for jobs with a TTL
+// after finishing.
+func waitForJobV1Completion(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) (*batchv1.Job, error) {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.BatchV1().Jobs(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.BatchV1().Jobs(ns).Watch(ctx, options)
+		})
+	deleted := false
+	obj, err := waitForObject(ctx, lw, &batchv1.Job{}, fmt.Sprintf("Job %s/%s", ns, name), "complete", timeout, func(obj runtime.Object) (bool, string, error) {
+		if obj == nil {
+			log.Printf("[DEBUG] Job %s/%s was deleted while waiting for it to complete", ns, name)
+			deleted = true
+			return true, "", nil
+		}
+		return jobV1CompletionStatus(obj.(*batchv1.Job))
+	})
+	job, ok := obj.(*batchv1.Job)
+	if err != nil {
+		if ok {
+			return nil, withPodProblems(ctx, conn, ns, job.Spec.Selector, err)
+		}
+		return nil, err
+	}
+	if deleted || !ok {
+		return nil, nil
+	}
+	return job, nil
+}
+
+// jobV1Finished returns a function reporting whether the job has completed,
+// for use with runningPodBySelector.
+func jobV1Finished(conn *kubernetes.Clientset, ns, name string) func(ctx context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
 		job, err := conn.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
-			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
-				return nil
-			}
-			return retry.NonRetryableError(err)
+			return false, err
 		}
+		done, _, err := jobV1CompletionStatus(job)
+		return done, err
+	}
+}
 
-		for _, c := range job.Status.Conditions {
-			if c.Status == corev1.ConditionTrue {
-				log.Printf("[DEBUG] Current condition of job: %s/%s: %s\n", ns, name, c.Type)
-				switch c.Type {
-				case batchv1.JobComplete:
-					return nil
-				case batchv1.JobFailed:
-					return retry.NonRetryableError(fmt.Errorf("job: %s/%s is in failed state", ns, name))
-				}
-			}
+// jobV1CompletionStatus reports whether the job has completed. Failed jobs,
+// e.g. jobs which exceeded their backoff limit or active deadline, are
+// reported as an error.
+func jobV1CompletionStatus(job *batchv1.Job) (bool, string, error) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, "", nil
+		case batchv1.JobFailed:
+			return false, "", fmt.Errorf("Job %s/%s failed: %s: %s", job.Namespace, job.Name, c.Reason, c.Message)
 		}
-
-		return retry.RetryableError(fmt.Errorf("job: %s/%s is not in complete state", ns, name))
 	}
+	return false, fmt.Sprintf("Waiting for job to complete: %d active, %d succeeded, %d failed pods", job.Status.Active, job.Status.Succeeded, job.Status.Failed), nil
 }
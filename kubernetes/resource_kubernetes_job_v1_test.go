@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -42,12 +44,31 @@ func TestAccKubernetesJobV1_wait_for_completion(t *testing.T) {
 					testAccCheckJobV1Waited(time.Duration(10)*time.Second),
 					testAccCheckKubernetesJobV1Exists(resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.active", "0"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccKubernetesJobV1_wait_for_completion_failed(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesJobV1Config_wait_for_completion_failed(name, imageName),
+				ExpectError: regexp.MustCompile(`Job .* failed: BackoffLimitExceeded`),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesJobV1_basic(t *testing.T) {
 	var conf batchv1.Job
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -211,6 +232,62 @@ func TestAccKubernetesJobV1_update(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesJobV1_suspend(t *testing.T) {
+	var conf1, conf2 batchv1.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+	resourceName := "kubernetes_job_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobV1Config_suspend(name, imageName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.suspend", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.active", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesJobV1Config_suspend(name, imageName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.suspend", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.succeeded", "1"),
+					testAccCheckKubernetesJobV1ForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJobV1_indexedCompletion(t *testing.T) {
+	var conf batchv1.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImage
+	resourceName := "kubernetes_job_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobV1Config_indexedCompletion(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.completion_mode", "Indexed"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.completions", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.succeeded", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesJobV1_ttl_seconds_after_finished(t *testing.T) {
 	var conf batchv1.Job
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -457,6 +534,59 @@ func testAccKubernetesJobV1Config_ttl_seconds_after_finished(name, imageName str
 }`, name, imageName)
 }
 
+func testAccKubernetesJobV1Config_suspend(name, imageName string, suspend bool) string {
+	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    suspend = %t
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "%s"
+          command = ["echo", "'hello'"]
+        }
+      }
+    }
+  }
+  wait_for_completion = true
+  timeouts {
+    create = "1m"
+    update = "1m"
+  }
+}`, name, suspend, imageName)
+}
+
+func testAccKubernetesJobV1Config_indexedCompletion(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    completion_mode = "Indexed"
+    completions     = 3
+    parallelism     = 3
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "%s"
+          command = ["sh", "-c", "test -n \"$JOB_COMPLETION_INDEX\""]
+        }
+      }
+    }
+  }
+  wait_for_completion = true
+  timeouts {
+    create = "1m"
+  }
+}`, name, imageName)
+}
+
 func testAccKubernetesJobV1Config_wait_for_completion(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
   metadata {
@@ -483,6 +613,33 @@ func testAccKubernetesJobV1Config_wait_for_completion(name, imageName string) st
 }`, name, imageName)
 }
 
+func testAccKubernetesJobV1Config_wait_for_completion_failed(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    backoff_limit = 0
+    template {
+      metadata {
+        name = "wait-test"
+      }
+      spec {
+        container {
+          name    = "wait-test"
+          image   = "%s"
+          command = ["sh", "-c", "exit 1"]
+        }
+      }
+    }
+  }
+  wait_for_completion = true
+  timeouts {
+    create = "1m"
+  }
+}`, name, imageName)
+}
+
 func testAccKubernetesJobV1Config_modified(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
   metadata {
@@ -516,3 +673,124 @@ func testAccKubernetesJobV1Config_modified(name, imageName string) string {
   wait_for_completion = false
 }`, name, imageName)
 }
+
+func TestJobV1CompletionStatus(t *testing.T) {
+	cases := map[string]struct {
+		Status batchv1.JobStatus
+		Done   bool
+		Error  bool
+	}{
+		"running": {
+			Status: batchv1.JobStatus{Active: 1},
+		},
+		"complete": {
+			Status: batchv1.JobStatus{
+				Succeeded: 1,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+				},
+			},
+			Done: true,
+		},
+		"failed": {
+			Status: batchv1.JobStatus{
+				Failed: 1,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+				},
+			},
+			Error: true,
+		},
+		"condition not true": {
+			Status: batchv1.JobStatus{
+				Active: 1,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: corev1.ConditionFalse},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, status, err := jobV1CompletionStatus(&batchv1.Job{Status: tc.Status})
+			if (err != nil) != tc.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != tc.Done {
+				t.Fatalf("expected done to be %t, got %t (%s)", tc.Done, done, status)
+			}
+		})
+	}
+}
+
+func TestJobV1PodFailurePolicyValidation(t *testing.T) {
+	cases := map[string]struct {
+		rule  map[string]interface{}
+		valid bool
+	}{
+		"exit codes": {
+			rule: map[string]interface{}{
+				"action": "FailJob",
+				"on_exit_codes": []interface{}{map[string]interface{}{
+					"operator": "In",
+					"values":   []interface{}{42},
+				}},
+			},
+			valid: true,
+		},
+		"pod condition": {
+			rule: map[string]interface{}{
+				"action":           "Ignore",
+				"on_pod_condition": []interface{}{map[string]interface{}{"type": "DisruptionTarget"}},
+			},
+			valid: true,
+		},
+		"unknown action": {
+			rule: map[string]interface{}{
+				"action":           "Retry",
+				"on_pod_condition": []interface{}{map[string]interface{}{"type": "DisruptionTarget"}},
+			},
+		},
+		"unknown operator": {
+			rule: map[string]interface{}{
+				"action": "Count",
+				"on_exit_codes": []interface{}{map[string]interface{}{
+					"operator": "Equals",
+					"values":   []interface{}{42},
+				}},
+			},
+		},
+		"missing action": {
+			rule: map[string]interface{}{
+				"on_pod_condition": []interface{}{map[string]interface{}{"type": "DisruptionTarget"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"pod_failure_policy": []interface{}{map[string]interface{}{
+						"rule": []interface{}{tc.rule},
+					}},
+					"template": []interface{}{map[string]interface{}{
+						"metadata": []interface{}{map[string]interface{}{}},
+						"spec": []interface{}{map[string]interface{}{
+							"container": []interface{}{map[string]interface{}{"name": "test", "image": "busybox"}},
+						}},
+					}},
+				}},
+			}
+			diags := resourceKubernetesJobV1().Validate(terraform.NewResourceConfigRaw(raw))
+			if tc.valid && diags.HasError() {
+				t.Fatalf("unexpected validation errors: %v", diags)
+			}
+			if !tc.valid && !diags.HasError() {
+				t.Fatal("expected a validation error")
+			}
+		})
+	}
+}
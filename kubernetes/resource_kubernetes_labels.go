@@ -66,9 +66,11 @@ func resourceKubernetesLabels() *schema.Resource {
 				},
 			},
 			"labels": {
-				Type:        schema.TypeMap,
-				Description: "A map of labels to apply to the resource.",
-				Required:    true,
+				Type:         schema.TypeMap,
+				Description:  "A map of labels to apply to the resource.",
+				Required:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateLabels,
 			},
 			"force": {
 				Type:        schema.TypeBool,
@@ -173,7 +175,7 @@ func resourceKubernetesLabelsRead(ctx context.Context, d *schema.ResourceData, m
 func getManagedLabels(managedFields []v1.ManagedFieldsEntry, manager string) (map[string]interface{}, error) {
 	var labels map[string]interface{}
 	for _, m := range managedFields {
-		if m.Manager != manager {
+		if m.Manager != manager || m.FieldsV1 == nil {
 			continue
 		}
 		var mm map[string]interface{}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestAccKubernetesLabels_basic(t *testing.T) {
@@ -54,6 +55,16 @@ func TestAccKubernetesLabels_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "field_manager", "tftest"),
 				),
 			},
+			{
+				PreConfig: func() {
+					if err := removeConfigMapLabel(name, namespace, "test2"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccKubernetesLabels_basic(name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 			{
 				Config: testAccKubernetesLabels_modified(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -93,6 +104,18 @@ func createConfigMap(name, namespace string) error {
 	return err
 }
 
+// removeConfigMapLabel removes a label out-of-band, as another client would.
+func removeConfigMapLabel(name, namespace, label string) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	patch := fmt.Sprintf(`[{"op":"remove","path":"/metadata/labels/%s"}]`, label)
+	_, err = conn.CoreV1().ConfigMaps(namespace).Patch(ctx, name, types.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
 func destroyConfigMap(name, namespace string) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesLeaseV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A lease defines a lease concept, used for leader election and node heartbeats.",
+		CreateContext: resourceKubernetesLeaseV1Create,
+		ReadContext:   resourceKubernetesLeaseV1Read,
+		UpdateContext: resourceKubernetesLeaseV1Update,
+		DeleteContext: resourceKubernetesLeaseV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("lease", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec contains the specification of the lease.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"holder_identity": {
+							Type:        schema.TypeString,
+							Description: "Contains the identity of the holder of a current lease.",
+							Optional:    true,
+						},
+						"lease_duration_seconds": {
+							Type:         schema.TypeInt,
+							Description:  "Duration that candidates for a lease need to wait to force acquire it. This is measured against the time of last observed renew time.",
+							Optional:     true,
+							ValidateFunc: validatePositiveInteger,
+						},
+						"acquire_time": {
+							Type:             schema.TypeString,
+							Description:      "The time at which the current lease was acquired, in RFC 3339 format.",
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressControllerAdvancedTime,
+						},
+						"renew_time": {
+							Type:             schema.TypeString,
+							Description:      "The time at which the current holder of the lease last updated it, in RFC 3339 format. The lease holder keeps moving it forward, so a diff is only shown when the configured value is later than the live one.",
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressControllerAdvancedTime,
+						},
+						"lease_transitions": {
+							Type:             schema.TypeInt,
+							Description:      "The number of transitions of a lease between holders. The lease holders keep incrementing it, so a diff is only shown when the configured value is higher than the live one.",
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IntAtLeast(0),
+							DiffSuppressFunc: suppressControllerAdvancedCounter,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesLeaseV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandLeaseV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lease := coordinationv1.Lease{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new lease: %#v", lease)
+	out, err := conn.CoordinationV1().Leases(metadata.Namespace).Create(ctx, &lease, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create lease: %s", err)
+	}
+	log.Printf("[INFO] Submitted new lease: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesLeaseV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesLeaseV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesLeaseV1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading lease %s", name)
+	lease, err := conn.CoordinationV1().Leases(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received lease: %#v", lease)
+
+	err = d.Set("metadata", flattenMetadata(lease.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenLeaseV1Spec(lease.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesLeaseV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		spec, err := expandLeaseV1Spec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, patchLeaseV1Spec(d, spec)...)
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating lease %q: %v", name, string(data))
+	out, err := conn.CoordinationV1().Leases(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update lease: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated lease: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesLeaseV1Read(ctx, d, meta)
+}
+
+// patchLeaseV1Spec only patches the fields that changed, so that the values
+// the lease holder maintains are not overwritten with stale state.
+func patchLeaseV1Spec(d *schema.ResourceData, spec coordinationv1.LeaseSpec) PatchOperations {
+	ops := PatchOperations{}
+	fields := []struct {
+		key   string
+		path  string
+		value interface{}
+		isSet bool
+	}{
+		{"holder_identity", "/spec/holderIdentity", spec.HolderIdentity, spec.HolderIdentity != nil},
+		{"lease_duration_seconds", "/spec/leaseDurationSeconds", spec.LeaseDurationSeconds, spec.LeaseDurationSeconds != nil},
+		{"acquire_time", "/spec/acquireTime", spec.AcquireTime, spec.AcquireTime != nil},
+		{"renew_time", "/spec/renewTime", spec.RenewTime, spec.RenewTime != nil},
+		{"lease_transitions", "/spec/leaseTransitions", spec.LeaseTransitions, spec.LeaseTransitions != nil},
+	}
+	for _, f := range fields {
+		if !d.HasChange("spec.0." + f.key) {
+			continue
+		}
+		if f.isSet {
+			ops = append(ops, &AddOperation{
+				Path:  f.path,
+				Value: f.value,
+			})
+		} else {
+			ops = append(ops, &RemoveOperation{
+				Path: f.path,
+			})
+		}
+	}
+	return ops
+}
+
+func resourceKubernetesLeaseV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting lease: %#v", name)
+	err = conn.CoordinationV1().Leases(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Lease %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesLeaseV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking lease %s", name)
+	_, err = conn.CoordinationV1().Leases(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
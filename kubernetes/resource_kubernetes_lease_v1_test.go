@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesLeaseV1_basic(t *testing.T) {
+	var conf coordinationv1.Lease
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_lease_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesLeaseV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesLeaseV1Config_basic(name, "holder-one", "2024-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesLeaseV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.holder_identity", "holder-one"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.lease_duration_seconds", "15"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.renew_time", "2024-01-01T00:00:00.000000Z"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.lease_transitions", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					lease, err := conn.CoordinationV1().Leases("default").Get(context.TODO(), name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+					renewTime := metav1.NowMicro()
+					transitions := int32(5)
+					lease.Spec.RenewTime = &renewTime
+					lease.Spec.LeaseTransitions = &transitions
+					_, err = conn.CoordinationV1().Leases("default").Update(context.TODO(), lease, metav1.UpdateOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccKubernetesLeaseV1Config_basic(name, "holder-one", "2024-01-01T00:00:00Z"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccKubernetesLeaseV1Config_basic(name, "holder-two", "2024-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesLeaseV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.holder_identity", "holder-two"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.lease_transitions", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesLeaseV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_lease_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.CoordinationV1().Leases(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Lease still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesLeaseV1Exists(n string, obj *coordinationv1.Lease) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.CoordinationV1().Leases(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesLeaseV1Config_basic(name, holder, renewTime string) string {
+	return fmt.Sprintf(`resource "kubernetes_lease_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    holder_identity        = %q
+    lease_duration_seconds = 15
+    renew_time             = %q
+    lease_transitions      = 1
+  }
+}
+`, name, holder, renewTime)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,35 +44,55 @@ func resourceKubernetesLimitRangeV1() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"default": {
-										Type:        schema.TypeMap,
-										Description: "Default resource requirement limit value by resource name if resource limit is omitted.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Default resource requirement limit value by resource name if resource limit is omitted.",
+										Optional:         true,
+										Elem:             schema.TypeString,
+										ValidateFunc:     validateResourceList,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"default_request": {
-										Type:        schema.TypeMap,
-										Description: "The default resource requirement request value by resource name if resource request is omitted.",
-										Optional:    true,
-										Computed:    true,
+										Type:             schema.TypeMap,
+										Description:      "The default resource requirement request value by resource name if resource request is omitted.",
+										Optional:         true,
+										Computed:         true,
+										Elem:             schema.TypeString,
+										ValidateFunc:     validateResourceList,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"max": {
-										Type:        schema.TypeMap,
-										Description: "Max usage constraints on this kind by resource name.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Max usage constraints on this kind by resource name.",
+										Optional:         true,
+										Elem:             schema.TypeString,
+										ValidateFunc:     validateResourceList,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"max_limit_request_ratio": {
-										Type:        schema.TypeMap,
-										Description: "The named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "The named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource.",
+										Optional:         true,
+										Elem:             schema.TypeString,
+										ValidateFunc:     validateResourceList,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"min": {
-										Type:        schema.TypeMap,
-										Description: "Min usage constraints on this kind by resource name.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Min usage constraints on this kind by resource name.",
+										Optional:         true,
+										Elem:             schema.TypeString,
+										ValidateFunc:     validateResourceList,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"type": {
 										Type:        schema.TypeString,
 										Description: "Type of resource that this limit applies to.",
 										Optional:    true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(api.LimitTypePod),
+											string(api.LimitTypeContainer),
+											string(api.LimitTypePersistentVolumeClaim),
+										}, false),
 									},
 								},
 							},
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -261,6 +262,42 @@ func TestAccKubernetesLimitRangeV1_multipleLimits(t *testing.T) {
 	})
 }
 
+func TestResourceKubernetesLimitRangeV1Quantities(t *testing.T) {
+	config := func(cpu string) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+			"spec": []interface{}{map[string]interface{}{
+				"limit": []interface{}{map[string]interface{}{
+					"type":    "Container",
+					"default": map[string]interface{}{"cpu": cpu},
+				}},
+			}},
+		}
+	}
+	r := resourceKubernetesLimitRangeV1()
+
+	t.Run("equivalent quantity", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, config("1"))
+		d.SetId("default/test")
+		diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config("1000m")), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != nil {
+			if attr, ok := diff.Attributes["spec.0.limit.0.default.cpu"]; ok {
+				t.Fatalf("expected no diff between 1 and 1000m, got: %#v", attr)
+			}
+		}
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		diags := r.Validate(terraform.NewResourceConfigRaw(config("one")))
+		if !diags.HasError() {
+			t.Fatal("expected an invalid quantity to be rejected")
+		}
+	})
+}
+
 func testAccCheckKubernetesLimitRangeDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -5,14 +5,18 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,9 +33,23 @@ func resourceKubernetesNamespaceV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceKubernetesNamespaceV1CustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("namespace", true),
+			"pod_security_admission_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Pod Security Admission configuration of the namespace, which is set as its `pod-security.kubernetes.io/*` labels. Labels of modes that are removed from the block are removed, and labels of modes that were never set in it are left alone. More info: https://kubernetes.io/docs/concepts/security/pod-security-admission/",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforce": podSecurityAdmissionModeSchema("Policy enforced on pods in the namespace. Pods violating it are rejected."),
+						"warn":    podSecurityAdmissionModeSchema("Policy for which clients are warned about violating pods."),
+						"audit":   podSecurityAdmissionModeSchema("Policy for which violating pods are recorded in the audit log."),
+					},
+				},
+			},
 			"wait_for_default_service_account": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -45,6 +63,51 @@ func resourceKubernetesNamespaceV1() *schema.Resource {
 	}
 }
 
+func podSecurityAdmissionModeSchema(description string) *schema.Schema {
+	modes := make([]string, len(podSecurityModes))
+	for i, mode := range podSecurityModes {
+		modes[i] = "pod_security_admission_profile.0." + mode
+	}
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  description,
+		AtLeastOneOf: modes,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"level": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Pod Security Standard level, one of `privileged`, `baseline` or `restricted`.",
+					ValidateFunc: validation.StringInSlice([]string{podSecurityLevelPrivileged, podSecurityLevelBaseline, podSecurityLevelRestricted}, false),
+				},
+				"version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      podSecurityVersionLatest,
+					Description:  "Kubernetes minor version whose definition of the level is used, e.g. `v1.30`, or `latest`. Defaults to `latest`.",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(latest|v1\.(0|[1-9][0-9]*))$`), "must be `latest` or a Kubernetes minor version such as `v1.30`"),
+				},
+			},
+		},
+	}
+}
+
+// resourceKubernetesNamespaceV1CustomizeDiff rejects pod security labels in
+// metadata when they are also managed by pod_security_admission_profile.
+func resourceKubernetesNamespaceV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if n, _ := d.Get("pod_security_admission_profile.#").(int); n == 0 || !d.NewValueKnown("metadata.0.labels") {
+		return nil
+	}
+	for k := range d.Get("metadata.0.labels").(map[string]interface{}) {
+		if strings.HasPrefix(k, podSecurityLabelPrefix) {
+			return fmt.Errorf("metadata.0.labels: label %q conflicts with pod_security_admission_profile, which manages the %s* labels", k, podSecurityLabelPrefix)
+		}
+	}
+	return nil
+}
+
 func resourceKubernetesNamespaceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -52,6 +115,14 @@ func resourceKubernetesNamespaceV1Create(ctx context.Context, d *schema.Resource
 	}
 
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if v, ok := d.GetOk("pod_security_admission_profile"); ok {
+		if metadata.Labels == nil {
+			metadata.Labels = make(map[string]string)
+		}
+		for k, v := range expandPodSecurityAdmissionProfile(v.([]interface{})) {
+			metadata.Labels[k] = v
+		}
+	}
 	namespace := corev1.Namespace{
 		ObjectMeta: metadata,
 	}
@@ -108,6 +179,14 @@ func resourceKubernetesNamespaceV1Read(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received namespace: %#v", namespace)
+	// The pod security labels are only read back into the profile when it is
+	// configured, so that labels managed elsewhere don't show up as drift.
+	if v, ok := d.GetOk("pod_security_admission_profile"); ok && len(v.([]interface{})) > 0 {
+		err = d.Set("pod_security_admission_profile", flattenPodSecurityAdmissionProfile(namespace.Labels))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
@@ -136,6 +215,33 @@ func resourceKubernetesNamespaceV1Update(ctx context.Context, d *schema.Resource
 	log.Printf("[INFO] Submitted updated namespace: %#v", out)
 	d.SetId(out.Name)
 
+	// The labels are patched separately from metadata, whose patch may replace
+	// all labels, and with a merge patch, which tolerates removing labels
+	// that are already gone. Only modes that changed are patched, unless the
+	// metadata patch replaced the labels.
+	o, n := d.GetChange("pod_security_admission_profile")
+	labels := patchPodSecurityAdmissionProfile(o.([]interface{}), n.([]interface{}))
+	if d.HasChange("metadata.0.labels") {
+		for k, v := range expandPodSecurityAdmissionProfile(n.([]interface{})) {
+			labels[k] = v
+		}
+	}
+	if len(labels) > 0 {
+		data, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": labels,
+			},
+		})
+		if err != nil {
+			return diag.Errorf("Failed to marshal pod security labels: %s", err)
+		}
+		log.Printf("[INFO] Updating pod security labels of namespace: %s", data)
+		_, err = conn.CoreV1().Namespaces().Patch(ctx, d.Id(), pkgApi.MergePatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesNamespaceV1Read(ctx, d, meta)
 }
 
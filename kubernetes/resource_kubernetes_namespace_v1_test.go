@@ -16,6 +16,67 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestResourceKubernetesNamespaceV1PodSecurityAdmissionProfile(t *testing.T) {
+	cases := map[string]struct {
+		labels  map[string]interface{}
+		profile map[string]interface{}
+		err     string
+	}{
+		"no profile": {},
+		"profile": {
+			profile: map[string]interface{}{
+				"enforce": []interface{}{map[string]interface{}{"level": "baseline", "version": "v1.30"}},
+			},
+		},
+		"empty profile": {
+			profile: map[string]interface{}{},
+			err:     `one of .+ must be specified`,
+		},
+		"invalid version": {
+			profile: map[string]interface{}{
+				"warn": []interface{}{map[string]interface{}{"level": "restricted", "version": "1.30"}},
+			},
+			err: "must be `latest` or a Kubernetes minor version",
+		},
+		"conflicting label": {
+			labels: map[string]interface{}{"pod-security.kubernetes.io/enforce": "restricted"},
+			profile: map[string]interface{}{
+				"enforce": []interface{}{map[string]interface{}{"level": "baseline"}},
+			},
+			err: `label "pod-security.kubernetes.io/enforce" conflicts with pod_security_admission_profile`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{
+					"name":   "test",
+					"labels": tc.labels,
+				}},
+			}
+			if tc.profile != nil {
+				raw["pod_security_admission_profile"] = []interface{}{tc.profile}
+			}
+			cfg := terraform.NewResourceConfigRaw(raw)
+			r := resourceKubernetesNamespaceV1()
+			diags := r.Validate(cfg)
+			var err error
+			if diags.HasError() {
+				err = fmt.Errorf("%v", diags)
+			} else {
+				_, err = r.Diff(context.Background(), nil, cfg, nil)
+			}
+			if tc.err != "" && (err == nil || !regexp.MustCompile(tc.err).MatchString(err.Error())) {
+				t.Fatalf("expected error matching %q, got: %v", tc.err, err)
+			}
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccKubernetesNamespaceV1_basic(t *testing.T) {
 	var conf corev1.Namespace
 	nsName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -233,6 +294,51 @@ func TestAccKubernetesNamespaceV1_deleteTimeout(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesNamespaceV1_podSecurityAdmissionProfile(t *testing.T) {
+	var conf corev1.Namespace
+	nsName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_namespace_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version", "pod_security_admission_profile"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNamespaceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNamespaceV1Config_podSecurityAdmissionProfile(nsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNamespaceV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.enforce.0.level", "baseline"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.enforce.0.version", "latest"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.warn.0.level", "restricted"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.warn.0.version", "v1.30"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.audit.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckPodSecurityAdmissionLabels(nsName, map[string]string{
+						"pod-security.kubernetes.io/enforce": "baseline",
+						"pod-security.kubernetes.io/warn":    "restricted",
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesNamespaceV1Config_podSecurityAdmissionProfileModified(nsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNamespaceV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.enforce.0.level", "restricted"),
+					resource.TestCheckResourceAttr(resourceName, "pod_security_admission_profile.0.warn.#", "0"),
+					testAccCheckPodSecurityAdmissionLabels(nsName, map[string]string{
+						"pod-security.kubernetes.io/enforce": "restricted",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesNamespaceV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -389,6 +495,41 @@ func testAccKubernetesNamespaceV1Config_deleteTimeout(nsName string) string {
 `, nsName)
 }
 
+func testAccKubernetesNamespaceV1Config_podSecurityAdmissionProfile(nsName string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace_v1" "test" {
+  metadata {
+    labels = {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+  pod_security_admission_profile {
+    enforce {
+      level = "baseline"
+    }
+    warn {
+      level   = "restricted"
+      version = "v1.30"
+    }
+  }
+}
+`, nsName)
+}
+
+func testAccKubernetesNamespaceV1Config_podSecurityAdmissionProfileModified(nsName string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  pod_security_admission_profile {
+    enforce {
+      level = "restricted"
+    }
+  }
+}
+`, nsName)
+}
+
 func testAccKubernetesNamespaceV1Config_wait_for_default_service_acccount(nsName string) string {
 	return fmt.Sprintf(`resource "kubernetes_namespace_v1" "test" {
   metadata {
@@ -5,7 +5,9 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +45,7 @@ func resourceKubernetesNetworkPolicyV1() *schema.Resource {
 		ReadContext:   resourceKubernetesNetworkPolicyV1Read,
 		UpdateContext: resourceKubernetesNetworkPolicyV1Update,
 		DeleteContext: resourceKubernetesNetworkPolicyV1Delete,
+		CustomizeDiff: resourceKubernetesNetworkPolicyV1CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -55,200 +58,251 @@ func resourceKubernetesNetworkPolicyV1() *schema.Resource {
 				Required:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ingress": {
-							Type:        schema.TypeList,
-							Description: networkPolicyV1SpecIngressDoc,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"ports": {
-										Type:        schema.TypeList,
-										Description: networkPolicyV1IngressRulePortsDoc,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeString,
-													Description: networkPolicyV1PortPortDoc,
-													Optional:    true,
-												},
-												"end_port": {
-													Type:        schema.TypeInt,
-													Description: networkPolicyV1PortEndPortDoc,
-													Optional:    true,
-												},
-												"protocol": {
-													Type:        schema.TypeString,
-													Description: networkPolicyV1PortProtocolDoc,
-													Optional:    true,
-													Default:     "TCP",
-												},
+					Schema: networkPolicyV1SpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func networkPolicyV1SpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ingress": {
+			Type:        schema.TypeList,
+			Description: networkPolicyV1SpecIngressDoc,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ports": {
+						Type:        schema.TypeList,
+						Description: networkPolicyV1IngressRulePortsDoc,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port": {
+									Type:         schema.TypeString,
+									Description:  networkPolicyV1PortPortDoc,
+									Optional:     true,
+									ValidateFunc: validatePortNumOrName,
+								},
+								"end_port": {
+									Type:         schema.TypeInt,
+									Description:  networkPolicyV1PortEndPortDoc,
+									Optional:     true,
+									ValidateFunc: validatePortNum,
+								},
+								"protocol": {
+									Type:        schema.TypeString,
+									Description: networkPolicyV1PortProtocolDoc,
+									Optional:    true,
+									Default:     "TCP",
+								},
+							},
+						},
+					},
+					"from": {
+						Type:        schema.TypeList,
+						Description: networkPolicyV1IngressRuleFromDoc,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ip_block": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerIpBlockDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"cidr": {
+												Type:        schema.TypeString,
+												Description: ipBlockCidrDoc,
+												Optional:    true,
 											},
-										},
-									},
-									"from": {
-										Type:        schema.TypeList,
-										Description: networkPolicyV1IngressRuleFromDoc,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"ip_block": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerIpBlockDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"cidr": {
-																Type:        schema.TypeString,
-																Description: ipBlockCidrDoc,
-																Optional:    true,
-															},
-															"except": {
-																Type:        schema.TypeList,
-																Description: ipBlockExceptDoc,
-																Optional:    true,
-																Elem:        &schema.Schema{Type: schema.TypeString},
-															},
-														},
-													},
-												},
-												"namespace_selector": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerNamespaceSelectorDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: labelSelectorFields(true),
-													},
-												},
-												"pod_selector": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerPodSelectorDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: labelSelectorFields(true),
-													},
-												},
+											"except": {
+												Type:        schema.TypeList,
+												Description: ipBlockExceptDoc,
+												Optional:    true,
+												Elem:        &schema.Schema{Type: schema.TypeString},
 											},
 										},
 									},
 								},
+								"namespace_selector": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerNamespaceSelectorDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: labelSelectorFields(true),
+									},
+								},
+								"pod_selector": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerPodSelectorDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: labelSelectorFields(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"egress": {
+			Type:        schema.TypeList,
+			Description: networkPolicyV1SpecEgressDoc,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ports": {
+						Type:        schema.TypeList,
+						Description: networkPolicyV1EgressRulePortsDoc,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port": {
+									Type:         schema.TypeString,
+									Description:  networkPolicyV1PortPortDoc,
+									Optional:     true,
+									ValidateFunc: validatePortNumOrName,
+								},
+								"end_port": {
+									Type:         schema.TypeInt,
+									Description:  networkPolicyV1PortEndPortDoc,
+									Optional:     true,
+									ValidateFunc: validatePortNum,
+								},
+								"protocol": {
+									Type:        schema.TypeString,
+									Description: networkPolicyV1PortProtocolDoc,
+									Optional:    true,
+									Default:     "TCP",
+								},
 							},
 						},
-						"egress": {
-							Type:        schema.TypeList,
-							Description: networkPolicyV1SpecEgressDoc,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"ports": {
-										Type:        schema.TypeList,
-										Description: networkPolicyV1EgressRulePortsDoc,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeString,
-													Description: networkPolicyV1PortPortDoc,
-													Optional:    true,
-												},
-												"end_port": {
-													Type:        schema.TypeInt,
-													Description: networkPolicyV1PortEndPortDoc,
-													Optional:    true,
-												},
-												"protocol": {
-													Type:        schema.TypeString,
-													Description: networkPolicyV1PortProtocolDoc,
-													Optional:    true,
-													Default:     "TCP",
-												},
+					},
+					"to": {
+						Type:        schema.TypeList,
+						Description: networkPolicyV1EgressRuleToDoc,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ip_block": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerIpBlockDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"cidr": {
+												Type:        schema.TypeString,
+												Description: ipBlockCidrDoc,
+												Optional:    true,
 											},
-										},
-									},
-									"to": {
-										Type:        schema.TypeList,
-										Description: networkPolicyV1EgressRuleToDoc,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"ip_block": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerIpBlockDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"cidr": {
-																Type:        schema.TypeString,
-																Description: ipBlockCidrDoc,
-																Optional:    true,
-															},
-															"except": {
-																Type:        schema.TypeList,
-																Description: ipBlockExceptDoc,
-																Optional:    true,
-																Elem:        &schema.Schema{Type: schema.TypeString},
-															},
-														},
-													},
-												},
-												"namespace_selector": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerNamespaceSelectorDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: labelSelectorFields(true),
-													},
-												},
-												"pod_selector": {
-													Type:        schema.TypeList,
-													Description: networkPolicyV1PeerPodSelectorDoc,
-													Optional:    true,
-													MaxItems:    1,
-													Elem: &schema.Resource{
-														Schema: labelSelectorFields(true),
-													},
-												},
+											"except": {
+												Type:        schema.TypeList,
+												Description: ipBlockExceptDoc,
+												Optional:    true,
+												Elem:        &schema.Schema{Type: schema.TypeString},
 											},
 										},
 									},
 								},
+								"namespace_selector": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerNamespaceSelectorDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: labelSelectorFields(true),
+									},
+								},
+								"pod_selector": {
+									Type:        schema.TypeList,
+									Description: networkPolicyV1PeerPodSelectorDoc,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: labelSelectorFields(true),
+									},
+								},
 							},
 						},
-						"pod_selector": {
-							Type:        schema.TypeList,
-							Description: networkPolicyV1SpecPodSelectorDoc,
-							Required:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: labelSelectorFields(true),
-							},
-						},
-						// The policy_types property is made required because the default value is only evaluated server side on resource creation.
-						// During the initial creation, a default value is determined and stored, then PolicyTypes is no longer considered unset,
-						// it will stick to that value on further updates unless explicitly overridden.
-						// Leaving the policy_types property optional here would prevent further updates adding egress rules after the initial resource creation
-						// without egress rules nor policy types from working as expected as PolicyTypes will stick to Ingress server side.
-						"policy_types": {
-							Type:        schema.TypeList,
-							Description: networkPolicyV1SpecPolicyTypesDoc,
-							Required:    true,
-							MinItems:    1,
-							MaxItems:    2,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-						},
 					},
 				},
 			},
 		},
+		"pod_selector": {
+			Type:        schema.TypeList,
+			Description: networkPolicyV1SpecPodSelectorDoc,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		// The policy_types property is made required because the default value is only evaluated server side on resource creation.
+		// During the initial creation, a default value is determined and stored, then PolicyTypes is no longer considered unset,
+		// it will stick to that value on further updates unless explicitly overridden.
+		// Leaving the policy_types property optional here would prevent further updates adding egress rules after the initial resource creation
+		// without egress rules nor policy types from working as expected as PolicyTypes will stick to Ingress server side.
+		"policy_types": {
+			Type:        schema.TypeList,
+			Description: networkPolicyV1SpecPolicyTypesDoc,
+			Required:    true,
+			MinItems:    1,
+			MaxItems:    2,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 	}
 }
 
+// resourceKubernetesNetworkPolicyV1CustomizeDiff checks the port ranges of
+// the ingress and egress rules. Ports that are not known yet are skipped.
+func resourceKubernetesNetworkPolicyV1CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, direction := range []string{"ingress", "egress"} {
+		rules, _ := diff.Get("spec.0." + direction).([]interface{})
+		for i, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ports, _ := rule["ports"].([]interface{})
+			for j, p := range ports {
+				port, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				endPort, _ := port["end_port"].(int)
+				portValue, _ := port["port"].(string)
+				if err := validateNetworkPolicyV1PortRange(portValue, endPort); err != nil {
+					return fmt.Errorf("spec.0.%s.%d.ports.%d: %s", direction, i, j, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateNetworkPolicyV1PortRange checks that a port range starts at a
+// numeric port and does not end before it starts.
+func validateNetworkPolicyV1PortRange(port string, endPort int) error {
+	if endPort == 0 || port == "" {
+		return nil
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("`end_port` cannot be used with the named port %q, `port` must be a port number", port)
+	}
+	if endPort < p {
+		return fmt.Errorf("`end_port` (%d) must be greater than or equal to `port` (%d)", endPort, p)
+	}
+	return nil
+}
+
 func resourceKubernetesNetworkPolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
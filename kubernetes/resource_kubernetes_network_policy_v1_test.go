@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -367,6 +368,55 @@ func TestAccKubernetesNetworkPolicyV1_withEgressAtCreation(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesNetworkPolicyV1_invalidPortRange(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesNetworkPolicyV1Config_egressPortRange(name, "10000", 9000),
+				ExpectError: regexp.MustCompile("`end_port` \\(9000\\) must be greater than or equal to `port` \\(10000\\)"),
+			},
+			{
+				Config:      testAccKubernetesNetworkPolicyV1Config_egressPortRange(name, "dns", 9000),
+				ExpectError: regexp.MustCompile("`end_port` cannot be used with the named port \"dns\""),
+			},
+			{
+				Config:      testAccKubernetesNetworkPolicyV1Config_egressPortRange(name, "Not_A_Port", 0),
+				ExpectError: regexp.MustCompile("must contain only alpha-numeric characters"),
+			},
+		},
+	})
+}
+
+func TestValidateNetworkPolicyV1PortRange(t *testing.T) {
+	cases := []struct {
+		port    string
+		endPort int
+		valid   bool
+	}{
+		{"", 0, true},
+		{"80", 0, true},
+		{"http", 0, true},
+		{"8000", 9000, true},
+		{"8000", 8000, true},
+		{"", 9000, true},
+		{"9000", 8000, false},
+		{"http", 9000, false},
+	}
+	for _, tc := range cases {
+		err := validateNetworkPolicyV1PortRange(tc.port, tc.endPort)
+		if tc.valid && err != nil {
+			t.Errorf("port %q with end_port %d: unexpected error: %s", tc.port, tc.endPort, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("port %q with end_port %d: expected an error", tc.port, tc.endPort)
+		}
+	}
+}
+
 func testAccCheckKubernetesNetworkPolicyV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -740,3 +790,30 @@ func testAccKubernetesNetworkPolicyV1Config_withEgress(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesNetworkPolicyV1Config_egressPortRange(name, port string, endPort int) string {
+	endPortAttr := ""
+	if endPort != 0 {
+		endPortAttr = fmt.Sprintf("end_port = %d", endPort)
+	}
+	return fmt.Sprintf(`resource "kubernetes_network_policy_v1" "test" {
+  metadata {
+    name      = %q
+    namespace = "default"
+  }
+
+  spec {
+    pod_selector {}
+
+    egress {
+      ports {
+        port     = %q
+        protocol = "TCP"
+        %s
+      }
+    }
+    policy_types = ["Egress"]
+  }
+}
+`, name, port, endPortAttr)
+}
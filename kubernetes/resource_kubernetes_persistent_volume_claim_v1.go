@@ -17,9 +17,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 )
 
+var volumeSnapshotV1GroupVersionResource = apimachineryschema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
 func resourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 	fields := persistentVolumeClaimFields()
 	// The 'wait_until_bound' control attribute only makes sense in stand-alone PVCs,
@@ -52,8 +60,22 @@ func resourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 		// All fields of Spec are immutable after creation, except for resources.requests.storage.
 		// Storage can only be increased in place. A new object will be created when the storage is decreased.
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-			// Skip custom logic for resource creation.
 			if diff.Id() == "" {
+				// The API server mirrors one data source field into the other,
+				// so both can only be told apart from the configuration on creation.
+				_, dataSource := diff.GetOk("spec.0.data_source")
+				_, dataSourceRef := diff.GetOk("spec.0.data_source_ref")
+				if dataSource && dataSourceRef {
+					return fmt.Errorf("only one of `data_source` or `data_source_ref` can be set")
+				}
+				for _, key := range []string{"data_source", "data_source_ref"} {
+					if v, ok := diff.GetOk("spec.0." + key); ok {
+						if err := validatePersistentVolumeClaimDataSource(key, v.([]interface{})); err != nil {
+							return err
+						}
+					}
+				}
+				// Skip the remaining custom logic for resource creation.
 				return nil
 			}
 			key := "spec.0.resources.0.requests"
@@ -103,10 +125,14 @@ func resourceKubernetesPersistentVolumeClaimV1Create(ctx context.Context, d *sch
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// Claims restored from a snapshot stay pending until the snapshot is ready,
+	// let the user know up front when that is likely to happen.
+	diags := checkPersistentVolumeClaimSnapshotSource(ctx, meta, claim)
+
 	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
 	out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 	log.Printf("[INFO] Submitted new persistent volume claim: %#v", out)
 
@@ -149,12 +175,73 @@ func resourceKubernetesPersistentVolumeClaimV1Create(ctx context.Context, d *sch
 				}
 			}
 
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			return append(diags, diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))...)
 		}
 	}
 	log.Printf("[INFO] Persistent volume claim %s created", out.Name)
 
-	return resourceKubernetesPersistentVolumeClaimV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesPersistentVolumeClaimV1Read(ctx, d, meta)...)
+}
+
+func validatePersistentVolumeClaimDataSource(key string, l []interface{}) error {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	if in["kind"] == "VolumeSnapshot" && in["api_group"] != volumeSnapshotV1GroupVersionResource.Group {
+		return fmt.Errorf("`%s.api_group` must be %q when `kind` is \"VolumeSnapshot\"", key, volumeSnapshotV1GroupVersionResource.Group)
+	}
+	return nil
+}
+
+func checkPersistentVolumeClaimSnapshotSource(ctx context.Context, meta interface{}, claim *api.PersistentVolumeClaim) diag.Diagnostics {
+	namespace := claim.Namespace
+	var name string
+	switch ref := claim.Spec.DataSourceRef; {
+	case ref != nil:
+		if ref.Kind != "VolumeSnapshot" {
+			return nil
+		}
+		if ref.Namespace != nil && *ref.Namespace != "" {
+			namespace = *ref.Namespace
+		}
+		name = ref.Name
+	case claim.Spec.DataSource != nil:
+		if claim.Spec.DataSource.Kind != "VolumeSnapshot" {
+			return nil
+		}
+		name = claim.Spec.DataSource.Name
+	default:
+		return nil
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	warning := func(detail string) diag.Diagnostics {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unable to verify volume snapshot %s/%s", namespace, name),
+			Detail:   detail + " The persistent volume claim will stay pending until the snapshot is ready to use.",
+		}}
+	}
+
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return warning(err.Error())
+	}
+	snapshot, err := conn.Resource(volumeSnapshotV1GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return warning("The volume snapshot does not exist, or the VolumeSnapshot custom resource definition is not installed.")
+		}
+		return warning(err.Error())
+	}
+	ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	if !ready {
+		return warning("The volume snapshot is not ready to use yet.")
+	}
+	return nil
 }
 
 func resourceKubernetesPersistentVolumeClaimV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -562,6 +563,91 @@ func TestAccKubernetesPersistentVolumeClaimV1_volumeMode(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPersistentVolumeClaimV1_dataSource(t *testing.T) {
+	var conf corev1.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_persistent_volume_claim_v1.clone"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimV1Config_dataSource(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name+"-clone"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source.0.kind", "PersistentVolumeClaim"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaimV1_dataSourceConflict(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPersistentVolumeClaimV1Config_dataSourceConflict(name),
+				ExpectError: regexp.MustCompile("only one of `data_source` or `data_source_ref` can be set"),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaimV1_dataSourceSnapshotAPIGroup(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPersistentVolumeClaimV1Config_dataSourceSnapshot(name, ""),
+				ExpectError: regexp.MustCompile("`data_source.api_group` must be \"snapshot.storage.k8s.io\""),
+			},
+		},
+	})
+}
+
+func TestValidatePersistentVolumeClaimDataSource(t *testing.T) {
+	cases := map[string]struct {
+		kind     string
+		apiGroup string
+		wantErr  bool
+	}{
+		"PersistentVolumeClaim":      {"PersistentVolumeClaim", "", false},
+		"VolumeSnapshot":             {"VolumeSnapshot", "snapshot.storage.k8s.io", false},
+		"VolumeSnapshotMissingGroup": {"VolumeSnapshot", "", true},
+		"VolumeSnapshotWrongGroup":   {"VolumeSnapshot", "example.com", true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := []interface{}{map[string]interface{}{
+				"kind":      tc.kind,
+				"name":      "source",
+				"api_group": tc.apiGroup,
+			}}
+			err := validatePersistentVolumeClaimDataSource("data_source", l)
+			if tc.wantErr && err == nil {
+				t.Fatal("Expected an error, got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func testAccCheckKubernetesPersistentVolumeClaimV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -1224,6 +1310,100 @@ func testAccKubernetesPersistentVolumeClaimV1Config_volumeMode(name, volumeMode
 `, name, volumeMode)
 }
 
+func testAccKubernetesPersistentVolumeClaimV1Config_dataSource(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+  }
+
+  wait_until_bound = false
+}
+
+resource "kubernetes_persistent_volume_claim_v1" "clone" {
+  metadata {
+    name = "%[1]s-clone"
+  }
+
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source {
+      kind = "PersistentVolumeClaim"
+      name = kubernetes_persistent_volume_claim_v1.test.metadata.0.name
+    }
+  }
+
+  wait_until_bound = false
+}
+`, name)
+}
+
+func testAccKubernetesPersistentVolumeClaimV1Config_dataSourceConflict(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source {
+      kind = "PersistentVolumeClaim"
+      name = "source"
+    }
+    data_source_ref {
+      kind = "PersistentVolumeClaim"
+      name = "source"
+    }
+  }
+
+  wait_until_bound = false
+}
+`, name)
+}
+
+func testAccKubernetesPersistentVolumeClaimV1Config_dataSourceSnapshot(name, apiGroup string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source {
+      api_group = %q
+      kind      = "VolumeSnapshot"
+      name      = "snapshot"
+    }
+  }
+
+  wait_until_bound = false
+}
+`, name, apiGroup)
+}
+
 func testAccCheckKubernetesPersistentVolumeClaimV1ForceNew(old, new *corev1.PersistentVolumeClaim, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -25,6 +25,7 @@ import (
 const (
 	persistentVolumeAzureManagedError = `Unable to apply Azure Disk configuration. Managed disks require configuration: kind = "Managed"`
 	persistentVolumeAzureBlobError    = `Unable to apply Azure Disk configuration. Blob storage disks require configuration: kind = "Shared" or kind = "Dedicated"`
+	persistentVolumeBlockMountError   = `Unable to apply persistent volume configuration. Block volumes are not mounted and do not support mount_options, remove mount_options or set volume_mode = "Filesystem"`
 )
 
 func resourceKubernetesPersistentVolumeV1() *schema.Resource {
@@ -60,6 +61,12 @@ func resourceKubernetesPersistentVolumeV1() *schema.Resource {
 				log.Printf("Mismatch between Disk URI: %v = %v and disk Kind: %v = %v", diskURI, diskURIValue, kind, kindValue)
 				return errors.New(persistentVolumeAzureManagedError)
 			}
+			// Block volumes are handed to the pod as raw devices and never mounted.
+			if diff.Get("spec.0.volume_mode").(string) == string(api.PersistentVolumeBlock) {
+				if v, ok := diff.GetOk("spec.0.mount_options"); ok && v.(*schema.Set).Len() > 0 {
+					return errors.New(persistentVolumeBlockMountError)
+				}
+			}
 			// The following applies to Updates only.
 			if diff.Id() == "" {
 				return nil
@@ -184,7 +191,7 @@ func resourceKubernetesPersistentVolumeV1() *schema.Resource {
 						},
 						"mount_options": {
 							Type:        schema.TypeSet,
-							Description: "A list of mount options, e.g. [\"ro\", \"soft\"]. Not validated - mount will simply fail if one is invalid.",
+							Description: "A list of mount options, e.g. [\"ro\", \"soft\"]. Not validated - mount will simply fail if one is invalid. Cannot be used when `volume_mode` is `Block`.",
 							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Set:         schema.HashString,
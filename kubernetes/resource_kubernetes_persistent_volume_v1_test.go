@@ -878,6 +878,8 @@ func TestAccKubernetesPersistentVolumeV1_csi_secrets(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_source.0.csi.0.node_publish_secret_ref.0.namespace", "default"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_source.0.csi.0.controller_expand_secret_ref.0.name", name),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_source.0.csi.0.controller_expand_secret_ref.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_source.0.csi.0.node_expand_secret_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_source.0.csi.0.node_expand_secret_ref.0.namespace", "default"),
 				),
 			},
 		},
@@ -921,6 +923,21 @@ func TestAccKubernetesPersistentVolumeV1_volumeMode(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPersistentVolumeV1_volumeModeBlockMountOptions(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPersistentVolumeV1VolumeModeConfig_mountOptions(name, "Block"),
+				ExpectError: regexp.MustCompile(persistentVolumeBlockMountError),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPersistentVolumeV1_hostpath_claimRef(t *testing.T) {
 	var conf1, conf2 api.PersistentVolume
 	var conf3 api.PersistentVolumeClaim
@@ -1630,6 +1647,10 @@ func testAccKubernetesPersistentVolumeV1Config_csi_secrets(name string) string {
           name      = %[1]q
           namespace = "default"
         }
+        node_expand_secret_ref {
+          name      = %[1]q
+          namespace = "default"
+        }
       }
     }
   }
@@ -1637,6 +1658,31 @@ func testAccKubernetesPersistentVolumeV1Config_csi_secrets(name string) string {
 `, name)
 }
 
+func testAccKubernetesPersistentVolumeV1VolumeModeConfig_mountOptions(name, mode string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    capacity = {
+      storage = "1Gi"
+    }
+
+    access_modes  = ["ReadWriteOnce"]
+    volume_mode   = "%s"
+    mount_options = ["ro"]
+
+    persistent_volume_source {
+      host_path {
+        path = "/dev/null"
+      }
+    }
+  }
+}
+`, name, mode)
+}
+
 func testAccKubernetesPersistentVolumeV1VolumeModeConfig(name, mode string) string {
 	return fmt.Sprintf(`resource "kubernetes_persistent_volume_v1" "test" {
   metadata {
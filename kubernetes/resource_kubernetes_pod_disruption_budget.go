@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	copier "github.com/jinzhu/copier"
+	policyv1 "k8s.io/api/policy/v1"
 	api "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,18 +51,20 @@ func resourceKubernetesPodDisruptionBudget() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"max_unavailable": {
-							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetSpecMaxUnavailableDoc,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validateTypeStringNullableIntOrPercent,
+							Type:          schema.TypeString,
+							Description:   podDisruptionBudgetSpecMaxUnavailableDoc,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"spec.0.min_available"},
+							ValidateFunc:  validateTypeStringNullableIntOrPercent,
 						},
 						"min_available": {
-							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetSpecMinAvailableDoc,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validateTypeStringNullableIntOrPercent,
+							Type:          schema.TypeString,
+							Description:   podDisruptionBudgetSpecMinAvailableDoc,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"spec.0.max_unavailable"},
+							ValidateFunc:  validateTypeStringNullableIntOrPercent,
 						},
 						"selector": {
 							Type:        schema.TypeList,
@@ -97,7 +101,18 @@ func resourceKubernetesPodDisruptionBudgetUpdate(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Updating pod disruption budget %s: %s", d.Id(), ops)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	usepolicyv1beta1, err := usePolicyV1beta1(conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out := &api.PodDisruptionBudget{}
+	if usepolicyv1beta1 {
+		out, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	} else {
+		outv1 := &policyv1.PodDisruptionBudget{}
+		outv1, err = conn.PolicyV1().PodDisruptionBudgets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		copier.Copy(out, outv1)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -125,7 +140,20 @@ func resourceKubernetesPodDisruptionBudgetCreate(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Creating new pod disruption budget: %#v", pdb)
-	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, &pdb, metav1.CreateOptions{})
+	usepolicyv1beta1, err := usePolicyV1beta1(conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out := &api.PodDisruptionBudget{}
+	if usepolicyv1beta1 {
+		out, err = conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, &pdb, metav1.CreateOptions{})
+	} else {
+		requestv1 := &policyv1.PodDisruptionBudget{}
+		responsev1 := &policyv1.PodDisruptionBudget{}
+		copier.Copy(requestv1, pdb)
+		responsev1, err = conn.PolicyV1().PodDisruptionBudgets(metadata.Namespace).Create(ctx, requestv1, metav1.CreateOptions{})
+		copier.Copy(out, responsev1)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -156,7 +184,18 @@ func resourceKubernetesPodDisruptionBudgetRead(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("[INFO] Reading pod disruption budget %s", name)
-	pdb, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	usepolicyv1beta1, err := usePolicyV1beta1(conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	pdb := &api.PodDisruptionBudget{}
+	if usepolicyv1beta1 {
+		pdb, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		pdbv1 := &policyv1.PodDisruptionBudget{}
+		pdbv1, err = conn.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		copier.Copy(pdb, pdbv1)
+	}
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
@@ -188,7 +227,15 @@ func resourceKubernetesPodDisruptionBudgetDelete(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Deleting pod disruption budget %#v", name)
-	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	usepolicyv1beta1, err := usePolicyV1beta1(conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if usepolicyv1beta1 {
+		err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	} else {
+		err = conn.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return nil
@@ -215,7 +262,15 @@ func resourceKubernetesPodDisruptionBudgetExists(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Checking pod disruption budget %s", name)
-	_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	usepolicyv1beta1, err := usePolicyV1beta1(conn)
+	if err != nil {
+		return false, err
+	}
+	if usepolicyv1beta1 {
+		_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		_, err = conn.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
 	if err != nil {
 		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
 			return false, nil
@@ -49,18 +49,20 @@ func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"max_unavailable": {
-							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetV1SpecMaxUnavailableDoc,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validateTypeStringNullableIntOrPercent,
+							Type:          schema.TypeString,
+							Description:   podDisruptionBudgetV1SpecMaxUnavailableDoc,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"spec.0.min_available"},
+							ValidateFunc:  validateTypeStringNullableIntOrPercent,
 						},
 						"min_available": {
-							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetV1SpecMinAvailableDoc,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validateTypeStringNullableIntOrPercent,
+							Type:          schema.TypeString,
+							Description:   podDisruptionBudgetV1SpecMinAvailableDoc,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"spec.0.max_unavailable"},
+							ValidateFunc:  validateTypeStringNullableIntOrPercent,
 						},
 						"selector": {
 							Type:        schema.TypeList,
@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -88,6 +89,21 @@ func TestAccKubernetesPodDisruptionBudgetV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPodDisruptionBudgetV1_conflictingSpec(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodDisruptionBudgetV1Config_conflictingSpec(name),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPodDisruptionBudgetV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -203,3 +219,22 @@ func testAccKubernetesPodDisruptionBudgetV1Config_minAvailable(name string) stri
 }
 `, name)
 }
+
+func testAccKubernetesPodDisruptionBudgetV1Config_conflictingSpec(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_disruption_budget_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    max_unavailable = 1
+    min_available   = 1
+    selector {
+      match_labels = {
+        name = "foo"
+      }
+    }
+  }
+}
+`, name)
+}
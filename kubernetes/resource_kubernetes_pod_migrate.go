@@ -11,6 +11,8 @@ import (
 
 func resourceKubernetesPodV0() *schema.Resource {
 	schemaV1 := resourceKubernetesPodSchemaV1()
+	// ephemeral_container was added after schema version 1 was introduced.
+	delete(schemaV1["spec"].Elem.(*schema.Resource).Schema, "ephemeral_container")
 	schemaV0 := patchPodSpecWithResourcesFieldV0(schemaV1)
 	return &schema.Resource{Schema: schemaV0}
 }
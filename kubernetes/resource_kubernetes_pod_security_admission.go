@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func resourceKubernetesPodSecurityAdmission() *schema.Resource {
+	levels := []string{podSecurityLevelPrivileged, podSecurityLevelBaseline, podSecurityLevelRestricted}
+	return &schema.Resource{
+		Description:   "This resource manages the [Pod Security Admission](https://kubernetes.io/docs/concepts/security/pod-security-admission/) labels of a namespace that already exists, which replace PodSecurityPolicies since Kubernetes 1.25. Like `kubernetes_labels`, it uses server-side apply to manage only the labels it sets. It can also suggest levels equivalent to an existing PodSecurityPolicy.",
+		CreateContext: resourceKubernetesPodSecurityAdmissionCreate,
+		ReadContext:   resourceKubernetesPodSecurityAdmissionRead,
+		UpdateContext: resourceKubernetesPodSecurityAdmissionUpdate,
+		DeleteContext: resourceKubernetesPodSecurityAdmissionDelete,
+		CustomizeDiff: resourceKubernetesPodSecurityAdmissionCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Description:  "Name of the namespace to label.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"enforce": {
+				Type:         schema.TypeString,
+				Description:  "Pod Security Standard level enforced on pods in the namespace. Pods violating it are rejected. One of `privileged`, `baseline` or `restricted`.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(levels, false),
+			},
+			"warn": {
+				Type:         schema.TypeString,
+				Description:  "Pod Security Standard level for which clients are warned about violating pods. One of `privileged`, `baseline` or `restricted`.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(levels, false),
+			},
+			"audit": {
+				Type:         schema.TypeString,
+				Description:  "Pod Security Standard level for which violating pods are recorded in the audit log. One of `privileged`, `baseline` or `restricted`.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(levels, false),
+			},
+			"psp_name": {
+				Type:        schema.TypeString,
+				Description: "Name of a PodSecurityPolicy to suggest equivalent levels for in `migration_from_psp`. Only clusters older than Kubernetes 1.25 still serve PodSecurityPolicies.",
+				Optional:    true,
+			},
+			"migration_from_psp": {
+				Type:        schema.TypeList,
+				Description: "Pod Security Standard level suggested for the PodSecurityPolicy named by `psp_name`. It is the most restrictive level admitting every pod the policy admits.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:        schema.TypeString,
+							Description: "Suggested level, one of `privileged`, `baseline` or `restricted`.",
+							Computed:    true,
+						},
+						"reasons": {
+							Type:        schema.TypeList,
+							Description: "What the policy allows that rules out a more restrictive level.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting labels that were created or edited outside of Terraform.",
+				Optional:    true,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the specified labels.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+// resourceKubernetesPodSecurityAdmissionCustomizeDiff recomputes the suggested
+// levels when a different PodSecurityPolicy is named.
+func resourceKubernetesPodSecurityAdmissionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("psp_name") {
+		return d.SetNewComputed("migration_from_psp")
+	}
+	return nil
+}
+
+func resourceKubernetesPodSecurityAdmissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("namespace").(string))
+	diags := resourceKubernetesPodSecurityAdmissionUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesPodSecurityAdmissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading pod security admission labels of namespace %s", name)
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			d.SetId("")
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Namespace deleted",
+				Detail:   fmt.Sprintf("The namespace %q has been deleted, so the resource has been removed from the state. You should recreate the namespace, or remove the resource from your configuration.", name),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	managedLabels, err := getManagedLabels(ns.GetManagedFields(), d.Get("field_manager").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("namespace", ns.Name)
+	for _, mode := range podSecurityModes {
+		key := podSecurityLabelPrefix + mode
+		_, managed := managedLabels["f:"+key]
+		if managed || d.Get(mode).(string) != "" {
+			d.Set(mode, ns.Labels[key])
+		} else {
+			d.Set(mode, "")
+		}
+	}
+
+	var diags diag.Diagnostics
+	migration := []interface{}{}
+	if pspName := d.Get("psp_name").(string); pspName != "" {
+		psp, err := conn.PolicyV1beta1().PodSecurityPolicies().Get(ctx, pspName, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "PodSecurityPolicy not found",
+				Detail:   fmt.Sprintf("The PodSecurityPolicy %q does not exist, or the cluster no longer serves PodSecurityPolicies, which were removed in Kubernetes 1.25. No levels are suggested for it.", pspName),
+			})
+		case err != nil:
+			return diag.FromErr(err)
+		default:
+			migration = flattenPodSecurityMigration(*psp)
+		}
+	}
+	if err := d.Set("migration_from_psp", migration); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceKubernetesPodSecurityAdmissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("namespace").(string)
+	// check the namespace exists before we try and patch it
+	_, err = conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if d.Id() == "" {
+			// if we are deleting then there is nothing to do
+			// if the namespace is gone
+			return nil
+		}
+		return diag.Errorf("The namespace %q does not exist", name)
+	}
+
+	// when deleting, apply no labels to release the ones we manage
+	labels := map[string]string{}
+	if d.Id() != "" {
+		for _, mode := range podSecurityModes {
+			if level := d.Get(mode).(string); level != "" {
+				labels[podSecurityLabelPrefix+mode] = level
+			}
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata": map[string]interface{}{
+			"name":   name,
+			"labels": labels,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = conn.CoreV1().Namespaces().Patch(ctx, name, types.ApplyPatchType, patch, metav1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptr.To(d.Get("force").(bool)),
+	})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Field manager conflict",
+				Detail:   fmt.Sprintf(`Another client is managing a field Terraform tried to update. Set "force" to true to override: %v`, err),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" {
+		// don't try to read if we're deleting
+		return nil
+	}
+	return resourceKubernetesPodSecurityAdmissionRead(ctx, d, meta)
+}
+
+func resourceKubernetesPodSecurityAdmissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return resourceKubernetesPodSecurityAdmissionUpdate(ctx, d, meta)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPodSecurityAdmission_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_pod_security_admission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if err := createPodSecurityAdmissionNamespace(name); err != nil {
+				t.Fatal(err)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return destroyPodSecurityAdmissionNamespace(name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodSecurityAdmissionConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "namespace", name),
+					resource.TestCheckResourceAttr(resourceName, "enforce", "baseline"),
+					resource.TestCheckResourceAttr(resourceName, "warn", "restricted"),
+					resource.TestCheckResourceAttr(resourceName, "audit", ""),
+					resource.TestCheckResourceAttr(resourceName, "migration_from_psp.#", "0"),
+					testAccCheckPodSecurityAdmissionLabels(name, map[string]string{
+						"pod-security.kubernetes.io/enforce": "baseline",
+						"pod-security.kubernetes.io/warn":    "restricted",
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesPodSecurityAdmissionConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforce", "restricted"),
+					resource.TestCheckResourceAttr(resourceName, "warn", ""),
+					resource.TestCheckResourceAttr(resourceName, "audit", "restricted"),
+					testAccCheckPodSecurityAdmissionLabels(name, map[string]string{
+						"pod-security.kubernetes.io/enforce": "restricted",
+						"pod-security.kubernetes.io/audit":   "restricted",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func createPodSecurityAdmissionNamespace(name string) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ns := v1.Namespace{}
+	ns.SetName(name)
+	_, err = conn.CoreV1().Namespaces().Create(context.Background(), &ns, metav1.CreateOptions{})
+	return err
+}
+
+func destroyPodSecurityAdmissionNamespace(name string) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	return conn.CoreV1().Namespaces().Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// testAccCheckPodSecurityAdmissionLabels checks that the pod security labels
+// of the namespace are exactly the expected ones.
+func testAccCheckPodSecurityAdmissionLabels(name string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ns, err := conn.CoreV1().Namespaces().Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, mode := range podSecurityModes {
+			key := podSecurityLabelPrefix + mode
+			if ns.Labels[key] != expected[key] {
+				return fmt.Errorf("expected label %s to be %q, got %q", key, expected[key], ns.Labels[key])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesPodSecurityAdmissionConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_security_admission" "test" {
+  namespace = %q
+  enforce   = "baseline"
+  warn      = "restricted"
+}
+`, name)
+}
+
+func testAccKubernetesPodSecurityAdmissionConfig_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_security_admission" "test" {
+  namespace = %q
+  enforce   = "restricted"
+  audit     = "restricted"
+}
+`, name)
+}
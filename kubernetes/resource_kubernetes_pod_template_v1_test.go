@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPodTemplateV1_basic(t *testing.T) {
+	var conf corev1.PodTemplate
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_pod_template_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodTemplateV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodTemplateV1Config_basic(name, busyboxImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodTemplateV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "template.0.metadata.0.labels.app", "test"),
+					resource.TestCheckResourceAttr(resourceName, "template.0.spec.0.container.0.name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "template.0.spec.0.container.0.image", busyboxImage),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesPodTemplateV1Config_basic(name, agnhostImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodTemplateV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "template.0.spec.0.container.0.image", agnhostImage),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodTemplateV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_pod_template_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.CoreV1().PodTemplates(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Pod template still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPodTemplateV1Exists(n string, obj *corev1.PodTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.CoreV1().PodTemplates(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPodTemplateV1Config_basic(name, image string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_template_v1" "test" {
+  metadata {
+    name = %q
+  }
+  template {
+    metadata {
+      labels = {
+        app = "test"
+      }
+    }
+    spec {
+      container {
+        name    = "test"
+        image   = %q
+        command = ["sleep", "3600"]
+      }
+    }
+  }
+}
+`, name, image)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPodV1() *schema.Resource {
@@ -27,6 +29,7 @@ func resourceKubernetesPodV1() *schema.Resource {
 		ReadContext:   resourceKubernetesPodV1Read,
 		UpdateContext: resourceKubernetesPodV1Update,
 		DeleteContext: resourceKubernetesPodV1Delete,
+		CustomizeDiff: resourceKubernetesPodV1CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -47,6 +50,24 @@ func resourceKubernetesPodV1() *schema.Resource {
 }
 
 func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
+	s := podSpecFields(false, false)
+	s["scheduling_gate"].DiffSuppressFunc = suppressRemovedSchedulingGates
+	// Container resources can be resized in place on clusters that support it,
+	// resourceKubernetesPodV1CustomizeDiff replaces the pod on the others.
+	resources := s["container"].Elem.(*schema.Resource).Schema["resources"]
+	resources.ForceNew = false
+	for _, k := range []string{"limits", "requests"} {
+		resources.Elem.(*schema.Resource).Schema[k].ForceNew = false
+	}
+	s["ephemeral_container"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: "List of ephemeral containers run in this pod, e.g. for debugging. Ephemeral containers are added to the running pod and can never be changed or removed. More info: https://kubernetes.io/docs/concepts/workloads/pods/ephemeral-containers/",
+		Elem: &schema.Resource{
+			Schema: ephemeralContainerFields(),
+		},
+	}
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("pod", true),
 		"spec": {
@@ -55,9 +76,10 @@ func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
 			Required:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
-				Schema: podSpecFields(false, false),
+				Schema: s,
 			},
 		},
+		"lifecycle_hook": lifecycleHookSchema("the pod"),
 		"target_state": {
 			Type:        schema.TypeList,
 			Description: fmt.Sprintf("A list of the pod phases that indicate whether it was successfully created. Options: %q, %q, %q, %q, %q. Default: %q. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-phase", corev1.PodPending, corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed, corev1.PodUnknown, corev1.PodRunning),
@@ -93,17 +115,30 @@ func resourceKubernetesPodV1Create(ctx context.Context, d *schema.ResourceData,
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}
+	if err := checkPodSpecSidecarContainers(conn, spec); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := podSpecWindowsWarnings(spec)
+	diags = append(diags, podSpecEphemeralVolumeWarnings(spec)...)
+	diags = append(diags, podSpecTopologySpreadWarnings(conn, spec)...)
 
 	log.Printf("[INFO] Creating new pod: %#v", pod)
 	out, err := conn.CoreV1().Pods(metadata.Namespace).Create(ctx, &pod, metav1.CreateOptions{})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 	log.Printf("[INFO] Submitted new pod: %#v", out)
 
 	d.SetId(buildId(out.ObjectMeta))
 
+	if v, ok := d.GetOk("spec.0.ephemeral_container"); ok {
+		err = updatePodV1EphemeralContainers(ctx, conn, metadata.Namespace, metadata.Name, v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Target:  expandPodTargetState(d.Get("target_state").([]interface{})),
 		Pending: []string{string(corev1.PodPending)},
@@ -124,13 +159,18 @@ func resourceKubernetesPodV1Create(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Pod", 3)
 		if wErr != nil {
-			return diag.FromErr(wErr)
+			return append(diags, diag.FromErr(wErr)...)
 		}
-		return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		return append(diags, diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))...)
 	}
 	log.Printf("[INFO] Pod %s created", out.Name)
 
-	return resourceKubernetesPodV1Read(ctx, d, meta)
+	hooks := expandLifecycleHooks(d.Get("lifecycle_hook").([]interface{}), lifecycleHookEventCreate)
+	if err := runLifecycleHooks(ctx, meta, metadata.Namespace, podByName(metadata.Name), hooks); err != nil {
+		return append(diags, rollBackCreate(ctx, d, meta, err, resourceKubernetesPodV1Delete)...)
+	}
+
+	return append(diags, resourceKubernetesPodV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesPodV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -165,10 +205,223 @@ func resourceKubernetesPodV1Update(ctx context.Context, d *schema.ResourceData,
 	}
 	log.Printf("[INFO] Submitted updated pod: %#v", out)
 
+	if err := resizePodV1Containers(ctx, conn, namespace, name, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("spec.0.ephemeral_container") {
+		err = updatePodV1EphemeralContainers(ctx, conn, namespace, name, d.Get("spec.0.ephemeral_container").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId(buildId(out.ObjectMeta))
 	return resourceKubernetesPodV1Read(ctx, d, meta)
 }
 
+// updatePodV1EphemeralContainers sets the ephemeral containers of a pod through the
+// ephemeralcontainers subresource, the only way to add them to an existing pod.
+func updatePodV1EphemeralContainers(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, containers []interface{}) error {
+	ecs, err := expandEphemeralContainers(containers)
+	if err != nil {
+		return err
+	}
+	pod, err := conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pod.Spec.EphemeralContainers = ecs
+
+	log.Printf("[INFO] Updating ephemeral containers of pod %s/%s: %#v", namespace, name, ecs)
+	_, err = conn.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, name, pod, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to update ephemeral containers of pod %s/%s: %s", namespace, name, err)
+	}
+	return nil
+}
+
+// resizePodV1Containers updates the resources of the containers of a pod in
+// place through the resize subresource.
+func resizePodV1Containers(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, d *schema.ResourceData) error {
+	ops := make(PatchOperations, 0)
+	n := d.Get("spec.0.container.#").(int)
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("spec.0.container.%d.resources", i)
+		if !d.HasChange(key) {
+			continue
+		}
+		resources, err := expandContainerResourceRequirements(d.Get(key).([]interface{}))
+		if err != nil {
+			return err
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  fmt.Sprintf("/spec/containers/%d/resources", i),
+			Value: resources,
+		})
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal resize operations: %s", err)
+	}
+
+	log.Printf("[INFO] Resizing containers of pod %s/%s: %s", namespace, name, ops)
+	_, err = conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{}, "resize")
+	if err != nil {
+		return fmt.Errorf("Failed to resize containers of pod %s/%s: %s", namespace, name, err)
+	}
+	return nil
+}
+
+// resourceKubernetesPodV1CustomizeDiff validates the pod spec and rejects plans
+// that change or remove an ephemeral container, since Kubernetes only allows
+// adding new ones. It also replaces the pod when container resources change on
+// a cluster that cannot resize them in place.
+func resourceKubernetesPodV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := podSpecCustomizeDiff("spec.0.")(ctx, d, meta); err != nil {
+		return err
+	}
+	if err := podV1ResizeCustomizeDiff(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" || !d.HasChange("spec.0.ephemeral_container") {
+		return nil
+	}
+	o, n := d.GetChange("spec.0.ephemeral_container")
+	oldContainers, newContainers := o.([]interface{}), n.([]interface{})
+	for i, c := range oldContainers {
+		name := c.(map[string]interface{})["name"].(string)
+		if i >= len(newContainers) {
+			return fmt.Errorf("ephemeral container %q cannot be removed: Kubernetes does not allow removing ephemeral containers from a pod, the pod has to be replaced instead", name)
+		}
+		if d.HasChange(fmt.Sprintf("spec.0.ephemeral_container.%d", i)) {
+			return fmt.Errorf("ephemeral container %q cannot be changed: Kubernetes does not allow changing ephemeral containers of a pod, add a new ephemeral container or replace the pod instead", name)
+		}
+	}
+	return nil
+}
+
+// podV1ResizeCustomizeDiff forces a new pod when the resources of a container
+// change in a way that cannot be applied in place, or the cluster is older than
+// 1.33, the first release that resizes containers in place by default.
+func podV1ResizeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	changed, keys := make([]string, 0), make([]string, 0)
+	n, _ := d.Get("spec.0.container.#").(int)
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("spec.0.container.%d.resources", i)
+		if !d.HasChange(key) {
+			continue
+		}
+		changed = append(changed, key)
+		oldValue, newValue := d.GetChange(key)
+		resizePolicy, _ := d.Get(fmt.Sprintf("spec.0.container.%d.resize_policy", i)).([]interface{})
+		if !podV1ContainerResizableInPlace(oldValue.([]interface{}), newValue.([]interface{}), resizePolicy) {
+			log.Printf("[INFO] Pod %s will be replaced: %s adds or removes resources, changes resources other than cpu and memory, changes the QoS class of the pod, or decreases the memory limit of a container that is not restarted on memory resizes, which cannot be done in place", d.Id(), key)
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) < len(changed) && !podV1InPlaceResizeSupported(meta) {
+		log.Printf("[INFO] Pod %s will be replaced: the cluster does not support resizing containers in place", d.Id())
+		keys = changed
+	}
+	for _, key := range keys {
+		for _, k := range []string{"limits", "requests"} {
+			if d.HasChange(key + ".0." + k) {
+				if err := d.ForceNew(key + ".0." + k); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// podV1ContainerResizableInPlace reports whether the resources of a container
+// can be changed from o to n in place. Only cpu and memory can be resized, and
+// only when the resources are neither added nor removed and their requests stay
+// equal to, or different from, their limits so the QoS class of the pod holds.
+// Kubernetes also refuses to decrease the memory limit in place unless the
+// resize policy of the container restarts it on memory resizes.
+func podV1ContainerResizableInPlace(o, n, resizePolicy []interface{}) bool {
+	oldResources, err := expandContainerResourceRequirements(o)
+	if err != nil {
+		return false
+	}
+	newResources, err := expandContainerResourceRequirements(n)
+	if err != nil {
+		return false
+	}
+	for _, l := range [][2]corev1.ResourceList{
+		{oldResources.Limits, newResources.Limits},
+		{oldResources.Requests, newResources.Requests},
+	} {
+		if len(l[0]) != len(l[1]) {
+			return false
+		}
+		for name, q := range l[0] {
+			nq, ok := l[1][name]
+			if !ok {
+				return false
+			}
+			if name != corev1.ResourceCPU && name != corev1.ResourceMemory && q.Cmp(nq) != 0 {
+				return false
+			}
+		}
+	}
+	if !reflect.DeepEqual(oldResources.Claims, newResources.Claims) {
+		return false
+	}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if resourceRequestEqualsLimit(oldResources, name) != resourceRequestEqualsLimit(newResources, name) {
+			return false
+		}
+	}
+	if oldLimit, ok := oldResources.Limits[corev1.ResourceMemory]; ok && newResources.Limits.Memory().Cmp(oldLimit) < 0 {
+		for _, p := range expandContainerResizePolicy(resizePolicy) {
+			if p.ResourceName == corev1.ResourceMemory {
+				return p.RestartPolicy == corev1.RestartContainer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func resourceRequestEqualsLimit(r *corev1.ResourceRequirements, name corev1.ResourceName) bool {
+	l, ok := r.Limits[name]
+	if !ok {
+		return false
+	}
+	q, ok := r.Requests[name]
+	return !ok || q.Cmp(l) == 0
+}
+
+// podV1InPlaceResizeSupported reports whether the cluster is Kubernetes 1.33
+// or later, which resizes containers in place through the resize subresource.
+func podV1InPlaceResizeSupported(meta interface{}) bool {
+	clientsets, ok := meta.(KubeClientsets)
+	if !ok {
+		return false
+	}
+	conn, err := clientsets.MainClientset()
+	if err != nil {
+		log.Printf("[WARN] Unable to determine whether the cluster supports resizing containers in place: %s", err)
+		return false
+	}
+	supported, err := serverVersionGreaterThanOrEqual(conn, "1.33.0")
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the server version: %s", err)
+		return false
+	}
+	return supported
+}
+
 func resourceKubernetesPodV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	exists, err := resourceKubernetesPodV1Exists(ctx, d, meta)
 	if err != nil {
@@ -205,6 +458,15 @@ func resourceKubernetesPodV1Read(ctx context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	serviceAccountName := "default"
+	if pod.Spec.ServiceAccountName != "" {
+		serviceAccountName = pod.Spec.ServiceAccountName
+	}
+	ecs, err := flattenEphemeralContainers(pod.Spec.EphemeralContainers, fmt.Sprintf("%s-token-([a-z0-9]{5})", serviceAccountName))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	podSpec[0].(map[string]interface{})["ephemeral_container"] = ecs
 
 	err = d.Set("spec", podSpec)
 	if err != nil {
@@ -950,6 +950,77 @@ func TestAccKubernetesPodV1_with_resource_requirements(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPodV1_resizeInPlace(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := busyboxImage
+	resourceName := "kubernetes_pod_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.33.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodV1ConfigWithResizePolicy(podName, imageName, "250m", "500m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.0.resource_name", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.0.restart_policy", "NotRequired"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.1.resource_name", "memory"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.1.restart_policy", "RestartContainer"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.requests.cpu", "250m"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.limits.cpu", "500m"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodV1ConfigWithResizePolicy(podName, imageName, "500m", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.requests.cpu", "500m"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.limits.cpu", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodV1_lifecycleHook(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := busyboxImage
+	resourceName := "kubernetes_pod_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodV1ConfigWithLifecycleHook(podName, imageName, `["sh", "-c", "echo failed >&2; exit 1"]`),
+				ExpectError: regexp.MustCompile(`lifecycle hook 0 \(sh -c echo failed >&2; exit 1\) failed: .*failed`),
+			},
+			{
+				Config: testAccKubernetesPodV1ConfigWithLifecycleHook(podName, imageName, `["sh", "-c", "echo seeded > /tmp/seed"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook.0.on_event", "create"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook.0.container", "containername"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook.0.timeout_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPodV1_with_empty_dir_volume(t *testing.T) {
 	var conf api.Pod
 
@@ -1370,7 +1441,7 @@ func TestAccKubernetesPodV1_bug1085(t *testing.T) {
 }
 
 func TestAccKubernetesPodV1_readinessGate(t *testing.T) {
-	var conf1 api.Pod
+	var conf1, conf2 api.Pod
 
 	podName := acctest.RandomWithPrefix("tf-acc-test")
 	secretName := acctest.RandomWithPrefix("tf-acc-test")
@@ -1389,6 +1460,14 @@ func TestAccKubernetesPodV1_readinessGate(t *testing.T) {
 					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
 				),
 			},
+			{
+				Config: testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName1, "haha"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, true),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.readiness_gate.0.condition_type", "haha"),
+				),
+			},
 			{
 				PreConfig: func() {
 					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
@@ -1397,26 +1476,23 @@ func TestAccKubernetesPodV1_readinessGate(t *testing.T) {
 					}
 					ctx := context.TODO()
 
-					conditions := conf1.Status.Conditions
-					testCondition := api.PodCondition{
-						Type:   api.PodConditionType("haha"),
-						Status: api.ConditionTrue,
-					}
-					updatedConditions := append(conditions, testCondition)
-					conf1.Status.Conditions = updatedConditions
 					p, err := conn.CoreV1().Pods("default").Get(ctx, podName, metav1.GetOptions{})
 					if err != nil {
 						t.Fatal(err)
 					}
+					p.Status.Conditions = append(p.Status.Conditions, api.PodCondition{
+						Type:   api.PodConditionType("haha"),
+						Status: api.ConditionTrue,
+					})
 					_, err = conn.CoreV1().Pods("default").UpdateStatus(ctx, p, metav1.UpdateOptions{})
 					if err != nil {
 						t.Fatal(err)
 					}
 				},
-				Config: testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName1),
+				Config: testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName1, "haha"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
-					resource.TestCheckResourceAttr(resourceName, "spec.0.readiness_gate.0.condition_type", "haha"),
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesPodV1Condition(&conf2, "haha", api.ConditionTrue),
 				),
 			},
 			{
@@ -1425,6 +1501,10 @@ func TestAccKubernetesPodV1_readinessGate(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
 			},
+			{
+				Config:      testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName1, "invalid condition"),
+				ExpectError: regexp.MustCompile(`condition_type \("invalid condition"\)`),
+			},
 		},
 	})
 }
@@ -1533,6 +1613,42 @@ func TestAccKubernetesPodV1_runtimeClassName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPodV1_runtimeClassOverhead(t *testing.T) {
+	var conf1 api.Pod
+
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod_v1.test"
+	runtimeHandler := fmt.Sprintf("runc-%s", name)
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfRunningInEks(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodV1ConfigRuntimeClassOverhead(name, imageName, runtimeHandler),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.runtime_class_name", runtimeHandler),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.overhead.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.overhead.0.pod_fixed.cpu", "250m"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.overhead.0.pod_fixed.memory", "120Mi"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPodV1_with_ephemeral_storage(t *testing.T) {
 	var (
 		pod api.Pod
@@ -1640,6 +1756,148 @@ func TestAccKubernetesPodV1_os(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPodV1_ephemeralContainer(t *testing.T) {
+	var conf1, conf2 api.Pod
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.25.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodV1ConfigEphemeralContainer(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ephemeral_container.#", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodV1ConfigEphemeralContainer(name, imageName, "debugger", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ephemeral_container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ephemeral_container.0.name", "debugger"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ephemeral_container.0.target_container_name", "containername"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ephemeral_container.1.name", "second"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config:      testAccKubernetesPodV1ConfigEphemeralContainer(name, imageName, "renamed", "second"),
+				ExpectError: regexp.MustCompile(`ephemeral container "debugger" cannot be changed`),
+			},
+			{
+				Config:      testAccKubernetesPodV1ConfigEphemeralContainer(name, imageName, "debugger"),
+				ExpectError: regexp.MustCompile(`ephemeral container "second" cannot be removed`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodV1_sidecarContainer(t *testing.T) {
+	var conf api.Pod
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.29.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodV1ConfigSidecarContainer(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.init_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.init_container.0.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.init_container.0.restart_policy", "Always"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodV1_schedulingGate(t *testing.T) {
+	var conf1, conf2 api.Pod
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodV1ConfigSchedulingGate(name, imageName, "example.com/first", "example.com/second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.0.name", "example.com/first"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.1.name", "example.com/second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"metadata.0.resource_version",
+					"target_state",
+				},
+			},
+			{
+				Config: testAccKubernetesPodV1ConfigSchedulingGate(name, imageName, "example.com/second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.0.name", "example.com/second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodV1Condition(pod *api.Pod, conditionType api.PodConditionType, status api.ConditionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, c := range pod.Status.Conditions {
+			if c.Type == conditionType {
+				if c.Status != status {
+					return fmt.Errorf("Expected pod condition %q to be %q, got %q", conditionType, status, c.Status)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("Pod condition %q not found in %#v", conditionType, pod.Status.Conditions)
+	}
+}
+
 func testAccCheckCSIDriverExists(csiDriverName string) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -2736,6 +2994,68 @@ func testAccKubernetesPodV1ConfigWithResourceRequirementsRequestsOnly(podName, i
 `, podName, imageName)
 }
 
+func testAccKubernetesPodV1ConfigWithResizePolicy(podName, imageName, cpuRequest, cpuLimit string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+
+      resize_policy {
+        resource_name  = "cpu"
+        restart_policy = "NotRequired"
+      }
+      resize_policy {
+        resource_name  = "memory"
+        restart_policy = "RestartContainer"
+      }
+
+      resources {
+        requests = {
+          cpu    = "%s"
+          memory = "64Mi"
+        }
+        limits = {
+          cpu    = "%s"
+          memory = "128Mi"
+        }
+      }
+    }
+    termination_grace_period_seconds = 1
+  }
+}
+`, podName, imageName, cpuRequest, cpuLimit)
+}
+
+func testAccKubernetesPodV1ConfigWithLifecycleHook(podName, imageName, command string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+    }
+    termination_grace_period_seconds = 1
+  }
+
+  lifecycle_hook {
+    on_event  = "create"
+    command   = %s
+    container = "containername"
+  }
+}
+`, podName, imageName, command)
+}
+
 func testAccKubernetesPodV1ConfigWithEmptyDirVolumes(podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
   metadata {
@@ -3008,7 +3328,7 @@ func testAccKubernetesPodV1ConfigEnableServiceLinks(podName, imageName string) s
 `, podName, imageName)
 }
 
-func testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName string) string {
+func testAccKubernetesPodV1ConfigReadinessGate(secretName, configMapName, podName, imageName, conditionType string) string {
 	return fmt.Sprintf(`resource "kubernetes_secret_v1" "test" {
   metadata {
     name = "%s"
@@ -3064,7 +3384,7 @@ resource "kubernetes_pod_v1" "test" {
     automount_service_account_token = false
 
     readiness_gate {
-      condition_type = "haha"
+      condition_type = %q
     }
     container {
       image = "%s"
@@ -3117,7 +3437,7 @@ resource "kubernetes_pod_v1" "test" {
     }
   }
 }
-`, secretName, secretName, configMapName, configMapName, podName, imageName)
+`, secretName, secretName, configMapName, configMapName, podName, conditionType, imageName)
 }
 
 func testAccKubernetesPodV1ConfigMinimal(name, imageName string) string {
@@ -3321,6 +3641,35 @@ resource "kubernetes_pod_v1" "test" {
 `, name, imageName, runtimeHandler)
 }
 
+func testAccKubernetesPodV1ConfigRuntimeClassOverhead(name, imageName, runtimeHandler string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class_v1" "test" {
+  metadata {
+    name = %[3]q
+  }
+  handler = "runc"
+  overhead {
+    pod_fixed = {
+      cpu    = "250m"
+      memory = "120Mi"
+    }
+  }
+}
+
+resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    runtime_class_name = kubernetes_runtime_class_v1.test.metadata.0.name
+    container {
+      image = %[2]q
+      name  = "containername"
+    }
+  }
+}
+`, name, imageName, runtimeHandler)
+}
+
 func testAccKubernetesCustomScheduler(name string) string {
 	// Source: https://kubernetes.io/docs/tasks/extend-kubernetes/configure-multiple-schedulers/
 	return fmt.Sprintf(`variable "namespace" {
@@ -3551,3 +3900,75 @@ func testAccKubernetesPodV1ConfigOS(name, imageName string) string {
 }
 `, name, imageName)
 }
+
+func testAccKubernetesPodV1ConfigEphemeralContainer(name, imageName string, ephemeralNames ...string) string {
+	ephemeralContainers := ""
+	for _, n := range ephemeralNames {
+		ephemeralContainers += fmt.Sprintf(`
+    ephemeral_container {
+      image                 = %q
+      name                  = %q
+      command               = ["sleep", "3600"]
+      target_container_name = "containername"
+    }`, imageName, n)
+	}
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+    }%s
+  }
+}
+`, name, imageName, ephemeralContainers)
+}
+
+func testAccKubernetesPodV1ConfigSchedulingGate(name, imageName string, gates ...string) string {
+	schedulingGates := ""
+	for _, g := range gates {
+		schedulingGates += fmt.Sprintf(`
+    scheduling_gate {
+      name = %q
+    }`, g)
+	}
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+    }%s
+  }
+  target_state = ["Pending"]
+}
+`, name, imageName, schedulingGates)
+}
+
+func testAccKubernetesPodV1ConfigSidecarContainer(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    init_container {
+      image          = "%s"
+      name           = "sidecar"
+      command        = ["sleep", "3600"]
+      restart_policy = "Always"
+    }
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+    }
+  }
+}
+`, name, imageName, imageName)
+}
@@ -5,7 +5,9 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -16,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPriorityClassV1() *schema.Resource {
@@ -48,6 +51,8 @@ func resourceKubernetesPriorityClassV1() *schema.Resource {
 				Description: "The value of this priority class. This is the actual priority that pods receive when they have the name of this class in their pod spec.",
 				Required:    true,
 				ForceNew:    true,
+				// Values above one billion are reserved for system critical priority classes.
+				ValidateFunc: validation.IntBetween(math.MinInt32, 1000000000),
 			},
 			"preemption_policy": {
 				Type:        schema.TypeString,
@@ -84,15 +89,20 @@ func resourceKubernetesPriorityClassV1Create(ctx context.Context, d *schema.Reso
 		PreemptionPolicy: (*v1.PreemptionPolicy)(&preemptionPolicy),
 	}
 
+	var diags diag.Diagnostics
+	if globalDefault {
+		diags = priorityClassV1GlobalDefaultWarnings(ctx, conn, metadata.Name)
+	}
+
 	log.Printf("[INFO] Creating new priority class: %#v", priorityClass)
 	out, err := conn.SchedulingV1().PriorityClasses().Create(ctx, &priorityClass, metav1.CreateOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to create priority class: %s", err)
+		return append(diags, diag.Errorf("Failed to create priority class: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted new priority class: %#v", out)
 	d.SetId(out.ObjectMeta.Name)
 
-	return resourceKubernetesPriorityClassV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesPriorityClassV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesPriorityClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -155,6 +165,11 @@ func resourceKubernetesPriorityClassV1Update(ctx context.Context, d *schema.Reso
 
 	name := d.Id()
 
+	var diags diag.Diagnostics
+	if d.HasChange("global_default") && d.Get("global_default").(bool) {
+		diags = priorityClassV1GlobalDefaultWarnings(ctx, conn, name)
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
 	if d.HasChange("description") {
@@ -188,12 +203,12 @@ func resourceKubernetesPriorityClassV1Update(ctx context.Context, d *schema.Reso
 	log.Printf("[INFO] Updating priority class %q: %v", name, string(data))
 	out, err := conn.SchedulingV1().PriorityClasses().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update priority class: %s", err)
+		return append(diags, diag.Errorf("Failed to update priority class: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted updated priority class: %#v", out)
 	d.SetId(out.ObjectMeta.Name)
 
-	return resourceKubernetesPriorityClassV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesPriorityClassV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesPriorityClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -237,3 +252,40 @@ func resourceKubernetesPriorityClassV1Exists(ctx context.Context, d *schema.Reso
 	}
 	return true, err
 }
+
+// priorityClassV1GlobalDefaults returns the names of the PriorityClasses other
+// than the named one which are marked as global default.
+func priorityClassV1GlobalDefaults(ctx context.Context, conn kubernetes.Interface, name string) ([]string, error) {
+	list, err := conn.SchedulingV1().PriorityClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, pc := range list.Items {
+		if pc.GlobalDefault && pc.Name != name {
+			names = append(names, pc.Name)
+		}
+	}
+	return names, nil
+}
+
+// priorityClassV1GlobalDefaultWarnings warns when the named PriorityClass
+// would become a second global default. CustomizeDiff cannot warn, so the
+// warnings are returned from create and update. The check needs access to the
+// cluster, so any failure to list existing classes is not fatal.
+func priorityClassV1GlobalDefaultWarnings(ctx context.Context, conn kubernetes.Interface, name string) diag.Diagnostics {
+	others, err := priorityClassV1GlobalDefaults(ctx, conn, name)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping global default priority class check: %s", err)
+		return nil
+	}
+	var diags diag.Diagnostics
+	for _, o := range others {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Multiple global default priority classes",
+			Detail:   fmt.Sprintf("Priority class %q is also marked as global default. Only one PriorityClass should set `global_default`; the one with the smallest value is used.", o),
+		})
+	}
+	return diags
+}
@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -154,6 +155,21 @@ func TestAccKubernetesPriorityClassV1_globalDefault(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPriorityClassV1_invalidValue(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPriorityClassV1Config_value(name, 2000000000),
+				ExpectError: regexp.MustCompile(`expected value to be in the range`),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPriorityClassV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -287,3 +303,14 @@ func testAccKubernetesPriorityClassV1Config_globalDefault(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesPriorityClassV1Config_value(name string, value int) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_class_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  value = %d
+}
+`, name, value)
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A priority level configuration represents the configuration of a priority level used by API Priority and Fairness.",
+		CreateContext: resourceKubernetesPriorityLevelConfigurationV1Beta3Create,
+		ReadContext:   resourceKubernetesPriorityLevelConfigurationV1Beta3Read,
+		UpdateContext: resourceKubernetesPriorityLevelConfigurationV1Beta3Update,
+		DeleteContext: resourceKubernetesPriorityLevelConfigurationV1Beta3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("priority level configuration", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec is the specification of the desired behavior of a priority level configuration.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Indicates whether this priority level is subject to limitation on request execution. Supported values are `Limited` and `Exempt`.",
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(flowcontrolv1beta3.PriorityLevelEnablementLimited),
+								string(flowcontrolv1beta3.PriorityLevelEnablementExempt),
+							}, false),
+						},
+						"limited": {
+							Type:          schema.TypeList,
+							Description:   "Specifies how requests are handled for a `Limited` priority level.",
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"spec.0.exempt"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nominal_concurrency_shares": {
+										Type:         schema.TypeInt,
+										Description:  "Contributes to the computation of the nominal concurrency limit of this level. This field replaces `assured_concurrency_shares` of earlier API versions. Defaults to 30.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"lendable_percent": {
+										Type:         schema.TypeInt,
+										Description:  "Prescribes the fraction of the level's nominal concurrency limit that can be borrowed by other priority levels. Must be between 0 and 100, inclusive.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"borrowing_limit_percent": {
+										Type:         schema.TypeInt,
+										Description:  "Limits the number of seats this priority level can borrow from other priority levels, expressed as a percentage of the level's nominal concurrency limit. When omitted, there is no limit.",
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"limit_response": {
+										Type:        schema.TypeList,
+										Description: "Indicates what to do with requests that can not be executed right now.",
+										Required:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:        schema.TypeString,
+													Description: "Indicates what to do with requests that can not be executed right now. Supported values are `Queue` and `Reject`.",
+													Required:    true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(flowcontrolv1beta3.LimitResponseTypeQueue),
+														string(flowcontrolv1beta3.LimitResponseTypeReject),
+													}, false),
+												},
+												"queuing": {
+													Type:        schema.TypeList,
+													Description: "Holds the configuration parameters for queuing. Only valid when `type` is `Queue`.",
+													Optional:    true,
+													Computed:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"queues": {
+																Type:         schema.TypeInt,
+																Description:  "The number of queues for this priority level. Defaults to 64.",
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"hand_size": {
+																Type:         schema.TypeInt,
+																Description:  "The number of queues dealt to each request when it is enqueued by shuffle sharding. Defaults to 8.",
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"queue_length_limit": {
+																Type:         schema.TypeInt,
+																Description:  "The maximum number of requests allowed to be waiting in a given queue of this priority level at a time. Defaults to 50.",
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"exempt": {
+							Type:          schema.TypeList,
+							Description:   "Specifies how requests are handled for an `Exempt` priority level.",
+							Optional:      true,
+							Computed:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"spec.0.limited"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nominal_concurrency_shares": {
+										Type:         schema.TypeInt,
+										Description:  "Contributes to the computation of the nominal concurrency limit of this level.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"lendable_percent": {
+										Type:         schema.TypeInt,
+										Description:  "Prescribes the fraction of the level's nominal concurrency limit that can be borrowed by other priority levels. Must be between 0 and 100, inclusive.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plc := flowcontrolv1beta3.PriorityLevelConfiguration{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandPriorityLevelConfigurationV1Beta3Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new priority level configuration: %#v", plc)
+	out, err := conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Create(ctx, &plc, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create priority level configuration: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted new priority level configuration: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesPriorityLevelConfigurationV1Beta3Read(ctx, d, meta)
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesPriorityLevelConfigurationV1Beta3Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading priority level configuration %s", name)
+	plc, err := conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received priority level configuration: %#v", plc)
+
+	err = d.Set("metadata", flattenMetadata(plc.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenPriorityLevelConfigurationV1Beta3Spec(plc.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandPriorityLevelConfigurationV1Beta3Spec(d.Get("spec").([]interface{})),
+		})
+	}
+
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating priority level configuration %q: %v", name, string(data))
+	out, err := conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update priority level configuration: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated priority level configuration: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesPriorityLevelConfigurationV1Beta3Read(ctx, d, meta)
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting priority level configuration: %#v", name)
+	err = conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Priority level configuration %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Beta3Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Checking priority level configuration %s", name)
+	_, err = conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+
+	return true, err
+}
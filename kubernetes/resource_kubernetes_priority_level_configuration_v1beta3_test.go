@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPriorityLevelConfigurationV1Beta3_basic(t *testing.T) {
+	var conf flowcontrolv1beta3.PriorityLevelConfiguration
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_priority_level_configuration_v1beta3.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.26.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPriorityLevelConfigurationV1Beta3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Beta3Config_reject(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityLevelConfigurationV1Beta3Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "Limited"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.nominal_concurrency_shares", "30"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.type", "Reject"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Beta3Config_queue(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityLevelConfigurationV1Beta3Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "Limited"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.nominal_concurrency_shares", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.lendable_percent", "20"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.type", "Queue"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queues", "16"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.hand_size", "4"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queue_length_limit", "25"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPriorityLevelConfigurationV1Beta3Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_priority_level_configuration_v1beta3" {
+			continue
+		}
+
+		resp, err := conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Priority level configuration still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPriorityLevelConfigurationV1Beta3Exists(n string, obj *flowcontrolv1beta3.PriorityLevelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out, err := conn.FlowcontrolV1beta3().PriorityLevelConfigurations().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Beta3Config_reject(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1beta3" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      limit_response {
+        type = "Reject"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Beta3Config_queue(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1beta3" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      nominal_concurrency_shares = 10
+      lendable_percent           = 20
+      limit_response {
+        type = "Queue"
+        queuing {
+          queues             = 16
+          hand_size          = 4
+          queue_length_limit = 25
+        }
+      }
+    }
+  }
+}
+`, name)
+}
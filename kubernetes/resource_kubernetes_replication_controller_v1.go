@@ -27,6 +27,7 @@ func resourceKubernetesReplicationControllerV1() *schema.Resource {
 		ReadContext:   resourceKubernetesReplicationControllerV1Read,
 		UpdateContext: resourceKubernetesReplicationControllerV1Update,
 		DeleteContext: resourceKubernetesReplicationControllerV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -67,10 +68,11 @@ func resourceKubernetesReplicationControllerV1Schema() map[string]*schema.Schema
 						Default:     0,
 					},
 					"replicas": {
-						Type:        schema.TypeInt,
-						Description: "The number of desired replicas. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#what-is-a-replicationcontroller",
-						Optional:    true,
-						Default:     1,
+						Type:             schema.TypeInt,
+						Description:      "The number of desired replicas. Defaults to 1. It is only used on creation if `manage_replicas` is false. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#what-is-a-replicationcontroller",
+						Optional:         true,
+						Default:          1,
+						DiffSuppressFunc: suppressUnmanagedReplicationControllerReplicas,
 					},
 					"selector": {
 						Type:        schema.TypeMap,
@@ -89,9 +91,22 @@ func resourceKubernetesReplicationControllerV1Schema() map[string]*schema.Schema
 				},
 			},
 		},
+		"manage_replicas": {
+			Type:        schema.TypeBool,
+			Description: "Whether Terraform manages the number of replicas after the replication controller has been created. Set it to false when the replication controller is scaled outside of Terraform, e.g. with `kubectl scale`, so the replica count of the cluster is kept. Defaults to true.",
+			Optional:    true,
+			Default:     true,
+		},
 	}
 }
 
+// suppressUnmanagedReplicationControllerReplicas ignores changes to the
+// replica count of an existing replication controller when manage_replicas
+// is false, so that scaling it outside of Terraform does not cause a diff.
+func suppressUnmanagedReplicationControllerReplicas(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !d.Get("manage_replicas").(bool)
+}
+
 func replicationControllerTemplateFieldSpec() map[string]*schema.Schema {
 	metadata := namespacedMetadataSchemaIsTemplate("replication controller's template", true, true)
 	metadata.Required = true
@@ -218,6 +233,15 @@ func resourceKubernetesReplicationControllerV1Update(ctx context.Context, d *sch
 			return diag.FromErr(err)
 		}
 
+		if !d.Get("manage_replicas").(bool) {
+			// Keep the replica count of the cluster, which may have been scaled outside of Terraform.
+			rc, err := conn.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			spec.Replicas = rc.Spec.Replicas
+		}
+
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
 			Value: spec,
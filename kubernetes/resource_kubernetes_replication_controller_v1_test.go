@@ -85,7 +85,7 @@ func TestAccKubernetesReplicationControllerV1_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "manage_replicas"},
 			},
 			{
 				Config: testAccKubernetesReplicationControllerV1Config_modified(name, imageName),
@@ -111,6 +111,47 @@ func TestAccKubernetesReplicationControllerV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesReplicationControllerV1_unmanagedReplicas(t *testing.T) {
+	var conf api.ReplicationController
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_replication_controller_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesReplicationControllerV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicationControllerV1ConfigUnmanagedReplicas(name, busyboxImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicationControllerV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "manage_replicas", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					scale, err := conn.CoreV1().ReplicationControllers("default").GetScale(context.TODO(), name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+					scale.Spec.Replicas = 2
+					_, err = conn.CoreV1().ReplicationControllers("default").UpdateScale(context.TODO(), name, scale, metav1.UpdateOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccKubernetesReplicationControllerV1ConfigUnmanagedReplicas(name, busyboxImage),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKubernetesReplicationControllerV1_initContainer(t *testing.T) {
 	var conf1, conf2 api.ReplicationController
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -194,7 +235,7 @@ func TestAccKubernetesReplicationControllerV1_generatedName(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "manage_replicas"},
 			},
 		},
 	})
@@ -1281,3 +1322,33 @@ func getReplicaCount() int {
 	}
 	return 50 // historical default value
 }
+
+func testAccKubernetesReplicationControllerV1ConfigUnmanagedReplicas(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_replication_controller_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  manage_replicas = false
+  spec {
+    replicas = 1
+    selector = {
+      test = "%s"
+    }
+    template {
+      metadata {
+        labels = {
+          test = "%s"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "containername"
+          command = ["sleep", "3600"]
+        }
+      }
+    }
+  }
+}
+`, name, name, name, imageName)
+}
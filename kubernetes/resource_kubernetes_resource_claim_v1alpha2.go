@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesResourceClaimV1Alpha2() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A resource claim describes a request for access to resources in the cluster, for use by workloads, e.g. a GPU or an RDMA device. It requires the `resource.k8s.io/v1alpha2` API, available in Kubernetes 1.27+ behind the `DynamicResourceAllocation` feature gate, and the `dynamic_resource_allocation` provider experiment.",
+		CreateContext: resourceKubernetesResourceClaimV1Alpha2Create,
+		ReadContext:   resourceKubernetesResourceClaimV1Alpha2Read,
+		UpdateContext: resourceKubernetesResourceClaimV1Alpha2Update,
+		DeleteContext: resourceKubernetesResourceClaimV1Alpha2Delete,
+		CustomizeDiff: resourceKubernetesResourceClaimV1Alpha2CustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("resource claim", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec describes the desired attributes of a resource that then needs to be allocated. It can only be set once when creating the resource claim.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_class_name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the resource class which describes the resource and which driver handles it.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+						"allocation_mode": {
+							Type:         schema.TypeString,
+							Description:  "Allocation can start immediately or when a pod wants to use the resource. `WaitForFirstConsumer` is the default.",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      string(resourcev1alpha2.AllocationModeWaitForFirstConsumer),
+							ValidateFunc: validation.StringInSlice([]string{string(resourcev1alpha2.AllocationModeWaitForFirstConsumer), string(resourcev1alpha2.AllocationModeImmediate)}, false),
+						},
+						"parameters_ref": {
+							Type:        schema.TypeList,
+							Description: "Reference to a separate object in the same namespace which holds the parameters of the claim for the driver. Its format is defined by the driver.",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:        schema.TypeString,
+										Description: "The group of the referenced object. It is empty for the core API.",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "The type of the referenced object, e.g. `ConfigMap`.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the referenced object.",
+										Required:    true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status describes whether the resource is available and with which attributes.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"driver_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the driver which handles the allocation, copied from the resource class.",
+							Computed:    true,
+						},
+						"allocation": {
+							Type:        schema.TypeList,
+							Description: "Set by the resource driver once the resource or set of resources has been allocated successfully.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_handle": {
+										Type:        schema.TypeList,
+										Description: "Information about the allocated resources, passed by the kubelet to the driver when a pod using them is started.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"driver_name": {
+													Type:        schema.TypeString,
+													Description: "The name of the kubelet plugin which handles the resource.",
+													Computed:    true,
+												},
+												"data": {
+													Type:        schema.TypeString,
+													Description: "Opaque data, defined by the driver, about the allocated resource.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+									"available_on_nodes": {
+										Type:        schema.TypeList,
+										Description: "The nodes on which the allocated resources are available. If empty, they are available everywhere.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: nodeSelectorFields(),
+										},
+									},
+									"shareable": {
+										Type:        schema.TypeBool,
+										Description: "Whether the allocated resources can be used by more than one consumer at a time.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"reserved_for": {
+							Type:        schema.TypeList,
+							Description: "The consumers, e.g. pods, which are currently allowed to use the claim.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:        schema.TypeString,
+										Description: "The group of the consumer. It is empty for the core API.",
+										Computed:    true,
+									},
+									"resource": {
+										Type:        schema.TypeString,
+										Description: "The type of the consumer, e.g. `pods`.",
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the consumer.",
+										Computed:    true,
+									},
+									"uid": {
+										Type:        schema.TypeString,
+										Description: "The UID of the consumer.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"deallocation_requested": {
+							Type:        schema.TypeBool,
+							Description: "Whether the resource driver has been asked to deallocate the claim.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesResourceClaimV1Alpha2CustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if m, ok := meta.(providerMetadata); ok && m.DynamicResourceAllocation {
+		return nil
+	}
+	return fmt.Errorf("resource claims require the `dynamic_resource_allocation` provider experiment to be enabled")
+}
+
+func checkResourceClaimV1Alpha2Available(conn *kubernetes.Clientset) error {
+	err := discovery.ServerSupportsVersion(conn.Discovery(), resourcev1alpha2.SchemeGroupVersion)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return fmt.Errorf("the %s API is not available in this cluster; resource claims require Kubernetes 1.27+ with the DynamicResourceAllocation feature gate enabled: %s", resourcev1alpha2.SchemeGroupVersion, err)
+	}
+	return nil
+}
+
+func resourceKubernetesResourceClaimV1Alpha2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkResourceClaimV1Alpha2Available(conn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	claim := resourcev1alpha2.ResourceClaim{
+		ObjectMeta: metadata,
+		Spec:       expandResourceClaimV1Alpha2Spec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new resource claim: %#v", claim)
+	out, err := conn.ResourceV1alpha2().ResourceClaims(metadata.Namespace).Create(ctx, &claim, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create resource claim: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted new resource claim: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesResourceClaimV1Alpha2Read(ctx, d, meta)
+}
+
+func resourceKubernetesResourceClaimV1Alpha2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesResourceClaimV1Alpha2Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading resource claim %s", name)
+	claim, err := conn.ResourceV1alpha2().ResourceClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received resource claim: %#v", claim)
+
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenResourceClaimV1Alpha2Spec(claim.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("status", flattenResourceClaimV1Alpha2Status(claim.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesResourceClaimV1Alpha2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The spec of a resource claim is immutable, only the metadata can be updated.
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating resource claim %q: %v", name, string(data))
+	out, err := conn.ResourceV1alpha2().ResourceClaims(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update resource claim: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated resource claim: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesResourceClaimV1Alpha2Read(ctx, d, meta)
+}
+
+func resourceKubernetesResourceClaimV1Alpha2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting resource claim: %#v", name)
+	err = conn.ResourceV1alpha2().ResourceClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Resource claim %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesResourceClaimV1Alpha2Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking resource claim %s", name)
+	_, err = conn.ResourceV1alpha2().ResourceClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+
+	return true, err
+}
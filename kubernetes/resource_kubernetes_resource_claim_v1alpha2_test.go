@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesResourceClaimV1Alpha2_basic(t *testing.T) {
+	var conf resourcev1alpha2.ResourceClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_resource_claim_v1alpha2.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoResourceClaimV1Alpha2(t)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesResourceClaimV1Alpha2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesResourceClaimV1Alpha2Config_basic(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesResourceClaimV1Alpha2Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_class_name", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.allocation_mode", "WaitForFirstConsumer"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.allocation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.reserved_for.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesResourceClaimV1Alpha2_experimentDisabled(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesResourceClaimV1Alpha2Config_basic("tf-acc-test", false),
+				ExpectError: regexp.MustCompile("dynamic_resource_allocation"),
+			},
+		},
+	})
+}
+
+func skipIfNoResourceClaimV1Alpha2(t *testing.T) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkResourceClaimV1Alpha2Available(conn); err != nil {
+		t.Skipf("The Kubernetes endpoint does not serve resource claims - skipping: %s", err)
+	}
+}
+
+func testAccCheckKubernetesResourceClaimV1Alpha2Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_resource_claim_v1alpha2" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ResourceV1alpha2().ResourceClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == name {
+				return fmt.Errorf("Resource claim still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesResourceClaimV1Alpha2Exists(n string, obj *resourcev1alpha2.ResourceClaim) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.ResourceV1alpha2().ResourceClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesResourceClaimV1Alpha2Config_basic(name string, experiment bool) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  experiments {
+    dynamic_resource_allocation = %t
+  }
+}
+
+resource "kubernetes_resource_claim_v1alpha2" "test" {
+  metadata {
+    name      = %q
+    namespace = "default"
+  }
+  spec {
+    resource_class_name = "example.com"
+  }
+}
+`, experiment, name)
+}
@@ -104,6 +104,27 @@ func resourceKubernetesResourceQuotaV1() *schema.Resource {
 					},
 				},
 			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status defines the actual enforced quota and its current usage. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hard": {
+							Type:        schema.TypeMap,
+							Description: "The set of enforced hard limits for each named resource.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"used": {
+							Type:        schema.TypeMap,
+							Description: "The current observed total usage of the resource in the namespace.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -193,6 +214,10 @@ func resourceKubernetesResourceQuotaV1Read(ctx context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("status", flattenResourceQuotaStatus(resQuota.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -45,6 +45,9 @@ func TestAccKubernetesResourceQuotaV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.limits.cpu", "2"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.limits.memory", "2Gi"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.pods", "4"),
+					resource.TestCheckResourceAttrSet(resourceName, "status.0.used.pods"),
 				),
 			},
 			{
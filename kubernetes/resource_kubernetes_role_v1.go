@@ -34,36 +34,7 @@ func resourceKubernetesRoleV1() *schema.Resource {
 				Description: "Rule defining a set of permissions for the role",
 				Required:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"api_groups": {
-							Type:        schema.TypeSet,
-							Description: "Name of the APIGroup that contains the resources",
-							Required:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Set:         schema.HashString,
-						},
-						"resources": {
-							Type:        schema.TypeSet,
-							Description: "List of resources that the rule applies to",
-							Required:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Set:         schema.HashString,
-						},
-						"resource_names": {
-							Type:        schema.TypeSet,
-							Description: "White list of names that the rule applies to",
-							Optional:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Set:         schema.HashString,
-						},
-						"verbs": {
-							Type:        schema.TypeSet,
-							Description: "List of Verbs that apply to ALL the ResourceKinds and AttributeRestrictions contained in this rule",
-							Required:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Set:         schema.HashString,
-						},
-					},
+					Schema: roleRuleSchema(),
 				},
 			},
 		},
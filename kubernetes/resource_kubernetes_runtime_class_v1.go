@@ -6,12 +6,10 @@ package kubernetes
 import (
 	"context"
 	"log"
-	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	nodev1 "k8s.io/api/node/v1"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -38,9 +36,51 @@ func resourceKubernetesRuntimeClassV1() *schema.Resource {
 				Type:         schema.TypeString,
 				Description:  "Specifies the underlying runtime and configuration that the CRI implementation will use to handle pods of this class",
 				Required:     true,
-				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?`), ""),
+				ValidateFunc: validateName,
 				ForceNew:     true,
 			},
+			"overhead": {
+				Type:        schema.TypeList,
+				Description: "Overhead represents the resource overhead associated with running a pod for a given RuntimeClass.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_fixed": {
+							Type:             schema.TypeMap,
+							Description:      "PodFixed represents the fixed resource overhead associated with running a pod.",
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateFunc:     validateResourceList,
+							DiffSuppressFunc: suppressEquivalentResourceQuantity,
+						},
+					},
+				},
+			},
+			"scheduling": {
+				Type:        schema.TypeList,
+				Description: "Scheduling holds the scheduling constraints to ensure that pods running with this RuntimeClass are scheduled to nodes that support it.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_selector": {
+							Type:        schema.TypeMap,
+							Description: "NodeSelector lists labels that must be present on nodes that support this RuntimeClass. Pods using this RuntimeClass can only be scheduled to a node matched by this selector.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"toleration": {
+							Type:        schema.TypeList,
+							Description: "Tolerations are appended (excluding duplicates) to pods running with this RuntimeClass during admission.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: tolerationFields(true),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -54,9 +94,20 @@ func resourceKubernetesRuntimeClassV1Create(ctx context.Context, d *schema.Resou
 
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 
+	overhead, err := expandRuntimeClassV1Overhead(d.Get("overhead").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	scheduling, err := expandRuntimeClassV1Scheduling(d.Get("scheduling").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	runtimeClass := nodev1.RuntimeClass{
 		ObjectMeta: metadata,
 		Handler:    d.Get("handler").(string),
+		Overhead:   overhead,
+		Scheduling: scheduling,
 	}
 
 	out, err := conn.NodeV1().RuntimeClasses().Create(ctx, &runtimeClass, metav1.CreateOptions{})
@@ -105,6 +156,16 @@ func resourceKubernetesRuntimeClassV1Read(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("overhead", flattenRuntimeClassV1Overhead(rc.Overhead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("scheduling", flattenRuntimeClassV1Scheduling(rc.Scheduling))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -118,6 +179,28 @@ func resourceKubernetesRuntimeClassV1Update(ctx context.Context, d *schema.Resou
 
 	patch := patchMetadata("metadata.0.", "/metadata/", d)
 
+	if d.HasChange("overhead") {
+		overhead, err := expandRuntimeClassV1Overhead(d.Get("overhead").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		patch = append(patch, &AddOperation{
+			Path:  "/overhead",
+			Value: overhead,
+		})
+	}
+
+	if d.HasChange("scheduling") {
+		scheduling, err := expandRuntimeClassV1Scheduling(d.Get("scheduling").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		patch = append(patch, &AddOperation{
+			Path:  "/scheduling",
+			Value: scheduling,
+		})
+	}
+
 	data, err := patch.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -77,6 +77,49 @@ func TestAccKubernetesRuntimeClassV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesRuntimeClassV1_overheadAndScheduling(t *testing.T) {
+	var conf nodev1.RuntimeClass
+	rcName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_runtime_class_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesRuntimeClassV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_overheadAndScheduling(rcName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "handler", "myclass"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.cpu", "250m"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.memory", "120Mi"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.node_selector.runtime", "myclass"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.key", "runtime"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.effect", "NoSchedule"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_basic(rcName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesRuntimeClassV1Config_basic(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_runtime_class_v1" "test" {
   metadata {
@@ -166,3 +209,30 @@ func testAccCheckKubernetesRuntimeClassV1Exists(n string, obj *nodev1.RuntimeCla
 		return nil
 	}
 }
+
+func testAccKubernetesRuntimeClassV1Config_overheadAndScheduling(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+  handler = "myclass"
+  overhead {
+    pod_fixed = {
+      cpu    = "250m"
+      memory = "120Mi"
+    }
+  }
+  scheduling {
+    node_selector = {
+      runtime = "myclass"
+    }
+    toleration {
+      key      = "runtime"
+      operator = "Equal"
+      value    = "myclass"
+      effect   = "NoSchedule"
+    }
+  }
+}
+`, name)
+}
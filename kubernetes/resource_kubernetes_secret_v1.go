@@ -39,12 +39,15 @@ func resourceKubernetesSecretV1() *schema.Resource {
 			}
 
 			// ForceNew if immutable has been set to true
-			// and there are any changes to data, binary_data, or immutable
+			// and there are any changes to data, binary_data, their
+			// write-only revisions, or immutable
 			immutable, _ := diff.GetChange("immutable")
 			if immutable.(bool) {
 				immutableFields := []string{
 					"data",
 					"binary_data",
+					"data_wo_revision",
+					"binary_data_wo_revision",
 					"immutable",
 				}
 				for _, f := range immutableFields {
@@ -112,7 +115,7 @@ func resourceKubernetesSecretV1() *schema.Resource {
 			"immutable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Ensures that data stored in the Secret cannot be updated (only object metadata can be modified).",
+				Description: "Ensures that data stored in the Secret cannot be updated (only object metadata can be modified). Changing it from true to false, or changing the secret data while it is true, forces a new secret to be created because the API rejects updates to an immutable secret.",
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -341,6 +341,40 @@ func TestAccKubernetesSecretV1_data_wo(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesSecretV1_immutableDataWo(t *testing.T) {
+	var conf1, conf2 corev1.Secret
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_secret_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretV1Config_immutableDataWo(name, 1, "password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_wo_revision", "1"),
+				),
+			},
+			// a new revision of the write-only data for the immutable secret will force recreate
+			{
+				Config: testAccKubernetesSecretV1Config_immutableDataWo(name, 2, "newpassword"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_wo_revision", "2"),
+					testAccCheckSecretV1Recreated(&conf1, &conf2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesSecretV1_binaryData_wo(t *testing.T) {
 	var conf corev1.Secret
 	prefix := "tf-acc-test-gen-"
@@ -670,6 +704,22 @@ func testAccKubernetesSecretV1Config_data_wo2(prefix string) string {
 `, prefix)
 }
 
+func testAccKubernetesSecretV1Config_immutableDataWo(name string, revision int, data string) string {
+	return fmt.Sprintf(`resource "kubernetes_secret_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  immutable = true
+
+  data_wo_revision = %d
+  data_wo = {
+    SECRET = %q
+  }
+}
+`, name, revision, data)
+}
+
 func testAccKubernetesSecretV1Config_binaryData_wo(prefix string, bd string) string {
 	return fmt.Sprintf(`resource "kubernetes_secret_v1" "test" {
   metadata {
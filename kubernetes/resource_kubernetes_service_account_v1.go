@@ -300,7 +300,8 @@ func resourceKubernetesServiceAccountV1Read(ctx context.Context, d *schema.Resou
 
 	defaultSecretName := d.Get("default_secret_name").(string)
 	log.Printf("[DEBUG] Default secret name is %q", defaultSecretName)
-	secrets := flattenServiceAccountSecrets(svcAcc.Secrets, defaultSecretName)
+	svcAccSecrets := removeGeneratedServiceAccountTokens(svcAcc.Secrets, svcAcc.Name, d.Get("secret").(*schema.Set).List())
+	secrets := flattenServiceAccountSecrets(svcAccSecrets, defaultSecretName)
 	log.Printf("[DEBUG] Flattened secrets: %#v", secrets)
 	err = d.Set("secret", secrets)
 	if err != nil {
@@ -15,9 +15,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesServiceV1() *schema.Resource {
@@ -27,6 +30,7 @@ func resourceKubernetesServiceV1() *schema.Resource {
 		ReadContext:   resourceKubernetesServiceV1Read,
 		UpdateContext: resourceKubernetesServiceV1Update,
 		DeleteContext: resourceKubernetesServiceV1Delete,
+		CustomizeDiff: resourceKubernetesServiceV1CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,13 +62,13 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 				Schema: map[string]*schema.Schema{
 					"allocate_load_balancer_node_ports": {
 						Type:        schema.TypeBool,
-						Description: "Defines if `NodePorts` will be automatically allocated for services with type `LoadBalancer`. It may be set to `false` if the cluster load-balancer does not rely on `NodePorts`.  If the caller requests specific `NodePorts` (by specifying a value), those requests will be respected, regardless of this field. This field may only be set for services with type `LoadBalancer`. Default is `true`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-nodeport-allocation",
+						Description: "Defines if `NodePorts` will be automatically allocated for services with type `LoadBalancer`. It may be set to `false` if the cluster load-balancer does not rely on `NodePorts`.  If the caller requests specific `NodePorts` (by specifying a value), those requests will be respected, regardless of this field. This field may only be set for services with type `LoadBalancer`. Node ports that are already allocated are not released when it is changed to `false`. Default is `true`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-nodeport-allocation",
 						Optional:    true,
 						Default:     true,
 					},
 					"cluster_ip": {
 						Type:        schema.TypeString,
-						Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies",
+						Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required, which requires type `ClusterIP`. Ignored if type is `ExternalName`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies",
 						Optional:    true,
 						ForceNew:    true,
 						Computed:    true,
@@ -148,10 +152,11 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 						}, false),
 					},
 					"load_balancer_class": {
-						Type:        schema.TypeString,
-						Description: "The class of the load balancer implementation this Service belongs to. If specified, the value of this field must be a label-style identifier, with an optional prefix. This field can only be set when the Service type is `LoadBalancer`. If not set, the default load balancer implementation is used. This field can only be set when creating or updating a Service to type `LoadBalancer`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class",
-						Optional:    true,
-						ForceNew:    true,
+						Type:         schema.TypeString,
+						Description:  "The class of the load balancer implementation this Service belongs to. If specified, the value of this field must be a label-style identifier, with an optional prefix. This field can only be set when the Service type is `LoadBalancer`. If not set, the default load balancer implementation is used. This field can only be set when creating or updating a Service to type `LoadBalancer`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class",
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validateQualifiedName,
 					},
 					"load_balancer_ip": {
 						Type:         schema.TypeString,
@@ -282,7 +287,7 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 					},
 					"health_check_node_port": {
 						Type:         schema.TypeInt,
-						Description:  "Specifies the Healthcheck NodePort for the service. Only effects when type is set to `LoadBalancer` and external_traffic_policy is set to `Local`.",
+						Description:  "Specifies the Healthcheck NodePort for the service. Only effects when type is set to `LoadBalancer` and external_traffic_policy is set to `Local`. If not set, Kubernetes allocates one, which is read back.",
 						Optional:     true,
 						Computed:     true,
 						ForceNew:     true,
@@ -302,6 +307,14 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"dns_a_records": {
+						Type:        schema.TypeList,
+						Description: "Addresses cluster DNS resolves the name of a headless service with a selector to, i.e. the addresses of its ready endpoints. IPv6 addresses are served as AAAA records. Empty for other services.",
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
 					"load_balancer": {
 						Type:     schema.TypeList,
 						Computed: true,
@@ -332,6 +345,46 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 	}
 }
 
+// resourceKubernetesServiceV1CustomizeDiff rejects combinations of fields the
+// API server refuses: a load_balancer_class set on a service that is not of
+// type LoadBalancer, and a headless service that is not of type ClusterIP.
+func resourceKubernetesServiceV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("spec.0.type") {
+		return nil
+	}
+	serviceType := d.Get("spec.0.type").(string)
+	if d.NewValueKnown("spec.0.load_balancer_class") {
+		class := d.Get("spec.0.load_balancer_class").(string)
+		if class != "" && serviceType != string(corev1.ServiceTypeLoadBalancer) {
+			return fmt.Errorf("spec.0.load_balancer_class can only be set when spec.0.type is %q, got %q", corev1.ServiceTypeLoadBalancer, serviceType)
+		}
+	}
+	if d.NewValueKnown("spec.0.cluster_ip") {
+		clusterIP := d.Get("spec.0.cluster_ip").(string)
+		if clusterIP == corev1.ClusterIPNone && serviceType != string(corev1.ServiceTypeClusterIP) {
+			return fmt.Errorf("spec.0.cluster_ip can only be %q for headless services, which require spec.0.type to be %q, got %q", corev1.ClusterIPNone, corev1.ServiceTypeClusterIP, serviceType)
+		}
+	}
+	return nil
+}
+
+// serviceDNSARecords returns the addresses cluster DNS resolves the name of a
+// headless service with a selector to. Errors are logged rather than returned,
+// since the service itself could be read.
+func serviceDNSARecords(ctx context.Context, conn *kubernetes.Clientset, svc *corev1.Service) []string {
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone || len(svc.Spec.Selector) == 0 {
+		return []string{}
+	}
+	slices, err := conn.DiscoveryV1().EndpointSlices(svc.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{discoveryv1.LabelServiceName: svc.Name}.String(),
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to list the endpoint slices of service %s/%s: %s", svc.Namespace, svc.Name, err)
+		return []string{}
+	}
+	return flattenEndpointSliceReadyAddresses(slices.Items)
+}
+
 func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -343,10 +396,13 @@ func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceDa
 		ObjectMeta: metadata,
 		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
 	}
+	diags := serviceLoadBalancerAnnotationWarnings(svc.ObjectMeta, svc.Spec)
+	diags = append(diags, serviceHealthCheckNodePortWarnings(svc.Spec)...)
+
 	log.Printf("[INFO] Creating new service: %#v", svc)
 	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 	log.Printf("[INFO] Submitted new service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
@@ -374,13 +430,13 @@ func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Service", 3)
 			if wErr != nil {
-				return diag.FromErr(wErr)
+				return append(diags, diag.FromErr(wErr)...)
 			}
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			return append(diags, diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))...)
 		}
 	}
 
-	return resourceKubernetesServiceV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesServiceV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -415,7 +471,8 @@ func resourceKubernetesServiceV1Read(ctx context.Context, d *schema.ResourceData
 	}
 
 	err = d.Set("status", []interface{}{
-		map[string][]interface{}{
+		map[string]interface{}{
+			"dns_a_records": serviceDNSARecords(ctx, conn, svc),
 			"load_balancer": flattenLoadBalancerStatus(svc.Status.LoadBalancer),
 		},
 	})
@@ -444,6 +501,18 @@ func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
+	if d.HasChanges("metadata.0.annotations", "spec.0.type") {
+		metadata := expandMetadata(d.Get("metadata").([]interface{}))
+		diags = serviceLoadBalancerAnnotationWarnings(metadata, expandServiceSpec(d.Get("spec").([]interface{})))
+	}
+	if d.HasChanges("spec.0.type", "spec.0.external_traffic_policy") {
+		diags = append(diags, serviceHealthCheckNodePortWarnings(expandServiceSpec(d.Get("spec").([]interface{})))...)
+	}
+	if d.HasChange("spec.0.allocate_load_balancer_node_ports") {
+		diags = append(diags, serviceNodePortDeallocationWarnings(expandServiceSpec(d.Get("spec").([]interface{})))...)
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	if d.HasChange("spec") {
 		serverVersion, err := getServerVersion(conn)
@@ -460,12 +529,12 @@ func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Updating service %q: %v", name, string(data))
 	out, err := conn.CoreV1().Services(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update service: %s", err)
+		return append(diags, diag.Errorf("Failed to update service: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted updated service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	return resourceKubernetesServiceV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesServiceV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesServiceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,6 +19,66 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+func TestResourceKubernetesServiceV1LoadBalancerClass(t *testing.T) {
+	cases := map[string]struct {
+		serviceType string
+		err         bool
+	}{
+		"load balancer": {serviceType: "LoadBalancer"},
+		"cluster ip":    {serviceType: "ClusterIP", err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"type":                tc.serviceType,
+					"load_balancer_class": "example.com/internal-vip",
+					"port":                []interface{}{map[string]interface{}{"port": 80}},
+				}},
+			}
+			_, err := resourceKubernetesServiceV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err && (err == nil || !regexp.MustCompile(`load_balancer_class can only be set when spec.0.type is "LoadBalancer"`).MatchString(err.Error())) {
+				t.Fatalf("expected a load_balancer_class error, got: %v", err)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestResourceKubernetesServiceV1Headless(t *testing.T) {
+	cases := map[string]struct {
+		serviceType string
+		err         bool
+	}{
+		"cluster ip": {serviceType: "ClusterIP"},
+		"node port":  {serviceType: "NodePort", err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"type":       tc.serviceType,
+					"cluster_ip": "None",
+					"port":       []interface{}{map[string]interface{}{"port": 80}},
+				}},
+			}
+			_, err := resourceKubernetesServiceV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err && (err == nil || !regexp.MustCompile(`cluster_ip can only be "None" for headless services`).MatchString(err.Error())) {
+				t.Fatalf("expected a headless service error, got: %v", err)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccKubernetesServiceV1_basic(t *testing.T) {
 	var conf corev1.Service
 	name := acctest.RandomWithPrefix("tf-acc-test")
@@ -16,11 +16,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 func resourceKubernetesStatefulSetV1() *schema.Resource {
@@ -30,6 +29,7 @@ func resourceKubernetesStatefulSetV1() *schema.Resource {
 		ReadContext:   resourceKubernetesStatefulSetV1Read,
 		UpdateContext: resourceKubernetesStatefulSetV1Update,
 		DeleteContext: resourceKubernetesStatefulSetV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec.0."),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,7 +66,7 @@ func resourceKubernetesStatefulSetSchemaV1() map[string]*schema.Schema {
 		},
 		"wait_for_rollout": {
 			Type:        schema.TypeBool,
-			Description: "Wait for the rollout of the stateful set to complete. Defaults to true.",
+			Description: "Wait for the rollout of the stateful set to complete, i.e. for all pods to be ready and, with the RollingUpdate strategy, for all pods at or above the partition ordinal to run the updated revision, within the create or update timeout. With the OnDelete strategy, only the readiness of the pods is waited for. Defaults to true.",
 			Default:     true,
 			Optional:    true,
 		},
@@ -88,12 +88,18 @@ func resourceKubernetesStatefulSetV1Create(ctx context.Context, d *schema.Resour
 		ObjectMeta: metadata,
 		Spec:       *spec,
 	}
+	if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := podSpecWindowsWarnings(&spec.Template.Spec)
+	diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
+	diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
 	log.Printf("[INFO] Creating new StatefulSet: %#v", statefulSet)
 
 	out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 	log.Printf("[INFO] Submitted new StatefulSet: %#v", out)
 
@@ -104,16 +110,13 @@ func resourceKubernetesStatefulSetV1Create(ctx context.Context, d *schema.Resour
 
 	if d.Get("wait_for_rollout").(bool) {
 		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", id)
-		namespace := out.ObjectMeta.Namespace
-		name := out.ObjectMeta.Name
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
+		err = waitForStatefulSetRollout(ctx, conn, out.GetNamespace(), out.GetName(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	return resourceKubernetesStatefulSetV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesStatefulSetV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesStatefulSetV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -197,6 +200,7 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 	}
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
+	var diags diag.Diagnostics
 	if d.HasChange("spec") {
 		log.Println("[TRACE] StatefulSet.Spec has changes")
 		specPatch, err := patchStatefulSetSpec(d)
@@ -204,6 +208,17 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 		ops = append(ops, specPatch...)
+
+		spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := checkPodSpecSidecarContainers(conn, &spec.Template.Spec); err != nil {
+			return diag.FromErr(err)
+		}
+		diags = podSpecWindowsWarnings(&spec.Template.Spec)
+		diags = append(diags, podSpecTopologySpreadWarnings(conn, &spec.Template.Spec)...)
+		diags = append(diags, podSpecEphemeralVolumeWarnings(&spec.Template.Spec)...)
 	}
 
 	data, err := ops.MarshalJSON()
@@ -213,21 +228,26 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 	log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
 	out, err := conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
 	if err != nil {
-		return diag.Errorf("Failed to update StatefulSet: %s", err)
+		return append(diags, diag.Errorf("Failed to update StatefulSet: %s", err)...)
 	}
 	log.Printf("[INFO] Submitted updated StatefulSet: %#v", out)
 
 	if d.Get("wait_for_rollout").(bool) {
+		if out.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType && d.HasChange("spec.0.template") {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Pods of StatefulSet %s are not replaced automatically", d.Id()),
+				Detail:   "The StatefulSet uses the OnDelete update strategy, so the updated pod template is only rolled out when the existing pods are deleted. The provider only waits for the existing pods to be ready.",
+			})
+		}
 		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", d.Id())
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
+		err = waitForStatefulSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return append(diags, diag.FromErr(err)...)
 		}
-		return diag.Diagnostics{}
 	}
 
-	return resourceKubernetesStatefulSetV1Read(ctx, d, meta)
+	return append(diags, resourceKubernetesStatefulSetV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesStatefulSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -272,49 +292,66 @@ func resourceKubernetesStatefulSetV1Delete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
-// retryUntilStatefulSetRolloutComplete checks if a given job finished its execution and is either in 'Complete' or 'Failed' state.
-func retryUntilStatefulSetRolloutComplete(ctx context.Context, conn *kubernetes.Clientset, ns, name string) retry.RetryFunc {
-	return func() *retry.RetryError {
-		res, err := conn.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+// waitForStatefulSetRollout watches the stateful set until its rollout is
+// complete or the timeout expires.
+func waitForStatefulSetRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	lw := listWatchByName(name,
+		func(options metav1.ListOptions) (runtime.Object, error) {
+			return conn.AppsV1().StatefulSets(ns).List(ctx, options)
+		},
+		func(options metav1.ListOptions) (watch.Interface, error) {
+			return conn.AppsV1().StatefulSets(ns).Watch(ctx, options)
+		})
+	obj, err := waitForRollout(ctx, lw, &appsv1.StatefulSet{}, fmt.Sprintf("StatefulSet %s/%s", ns, name), timeout, func(obj runtime.Object) (bool, string, error) {
+		return statefulSetRolloutStatus(obj.(*appsv1.StatefulSet))
+	})
+	if err == nil {
+		return nil
+	}
+	if sts, ok := obj.(*appsv1.StatefulSet); ok {
+		return withPodProblems(ctx, conn, ns, sts.Spec.Selector, err)
+	}
+	return err
+}
 
-		if res.Status.ReadyReplicas != *res.Spec.Replicas {
-			return retry.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
-		}
+// statefulSetRolloutStatus follows the logic of `kubectl rollout status`:
+// all pods have to be ready and, for rolling updates, all pods with an
+// ordinal at or above the partition have to run the update revision. Pods of
+// stateful sets with the OnDelete strategy are not replaced by the controller,
+// so only their readiness is waited for.
+func statefulSetRolloutStatus(sts *appsv1.StatefulSet) (bool, string, error) {
+	if sts.Status.ObservedGeneration == 0 || sts.Generation > sts.Status.ObservedGeneration {
+		return false, "Waiting for rollout to start", nil
+	}
 
-		// NOTE: This is what kubectl uses to determine if a rollout is done.
-		// We are using this here because the logic for determining if a StatefulSet
-		// is done is gnarly and we don't want to duplicate it in the provider.
-		gvk := appsv1.SchemeGroupVersion.WithKind("StatefulSet")
-		gk := gvk.GroupKind()
-		statusViewer, err := polymorphichelpers.StatusViewerFor(gk)
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	var replicas int32 = 1 // default, according to API docs
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
 
-		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(res)
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	if sts.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d of %d pods are ready...", sts.Status.ReadyReplicas, replicas), nil
+	}
 
-		// NOTE: For some reason, the Kind and apiVersion get lost when converting to unstructured.
-		obj["apiVersion"] = gvk.GroupVersion().String()
-		obj["kind"] = gvk.Kind
-		u := unstructured.Unstructured{Object: obj}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return true, "", nil
+	}
 
-		// NOTE: the revision parameter of the Status function below is not actually used.
-		// for StatefulSet so it is set to 0 here
-		_, done, err := statusViewer.Status(&u, 0)
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+	var partition int32
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		partition = *ru.Partition
+	}
 
-		if done {
-			return nil
+	if partition > 0 {
+		if sts.Status.UpdatedReplicas < replicas-partition {
+			return false, fmt.Sprintf("Waiting for partitioned rollout to finish: %d out of %d new pods have been updated...", sts.Status.UpdatedReplicas, replicas-partition), nil
 		}
+		return true, "", nil
+	}
 
-		return retry.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
+	if sts.Status.UpdatedReplicas < replicas || sts.Status.UpdateRevision != sts.Status.CurrentRevision {
+		return false, fmt.Sprintf("Waiting for rollout to finish: %d out of %d new pods have been updated to revision %s...", sts.Status.UpdatedReplicas, replicas, sts.Status.UpdateRevision), nil
 	}
+
+	return true, "", nil
 }
@@ -15,6 +15,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestAccKubernetesStatefulSetV1_minimal(t *testing.T) {
@@ -1238,7 +1239,7 @@ func testAccKubernetesStatefulSetV1ConfigUpdateStrategyOnDelete(name, imageName
     }
   }
 
-  wait_for_rollout = false
+  wait_for_rollout = true
 }
 `, name, imageName)
 }
@@ -1436,3 +1437,96 @@ func testAccKubernetesStatefulSetV1ConfigMinimalWithTemplateNamespace(name, imag
 }
 `, name, imageName)
 }
+
+func TestStatefulSetRolloutStatus(t *testing.T) {
+	cases := map[string]struct {
+		StatefulSet appsv1.StatefulSet
+		Done        bool
+	}{
+		"spec update not observed": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 3},
+			},
+		},
+		"pods not ready": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(int32(3))},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 2},
+			},
+		},
+		"rolling update in progress": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "a", UpdateRevision: "b"},
+			},
+		},
+		"rolling update complete": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "b", UpdateRevision: "b"},
+			},
+			Done: true,
+		},
+		"partitioned rollout in progress": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To(int32(1))},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "a", UpdateRevision: "b"},
+			},
+		},
+		"partitioned rollout complete": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To(int32(1))},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 2, CurrentRevision: "a", UpdateRevision: "b"},
+			},
+			Done: true,
+		},
+		"on delete with ready pods": {
+			StatefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       ptr.To(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentRevision: "a", UpdateRevision: "b"},
+			},
+			Done: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, status, err := statefulSetRolloutStatus(&tc.StatefulSet)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != tc.Done {
+				t.Fatalf("expected done to be %t, got %t (%s)", tc.Done, done, status)
+			}
+		})
+	}
+}
@@ -47,8 +47,9 @@ func resourceKubernetesStorageClassV1() *schema.Resource {
 			},
 			"reclaim_policy": {
 				Type:        schema.TypeString,
-				Description: "Indicates the type of the reclaim policy",
+				Description: "Indicates the type of the reclaim policy. Changing it forces a new storage class to be created, the API does not allow it to be updated.",
 				Optional:    true,
+				ForceNew:    true,
 				Default:     string(v1.PersistentVolumeReclaimDelete),
 				ValidateFunc: validation.StringInSlice([]string{
 					string(v1.PersistentVolumeReclaimRecycle),
@@ -58,7 +59,7 @@ func resourceKubernetesStorageClassV1() *schema.Resource {
 			},
 			"volume_binding_mode": {
 				Type:        schema.TypeString,
-				Description: "Indicates when volume binding and dynamic provisioning should occur",
+				Description: "Indicates when volume binding and dynamic provisioning should occur. Changing it forces a new storage class to be created, the API does not allow it to be updated.",
 				Optional:    true,
 				ForceNew:    true,
 				Default:     string(api.VolumeBindingImmediate),
@@ -93,18 +94,26 @@ func resourceKubernetesStorageClassV1() *schema.Resource {
 							Type:        schema.TypeList,
 							Description: "A list of topology selector requirements by labels.",
 							Optional:    true,
+							ForceNew:    true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"key": {
-										Type:        schema.TypeString,
-										Description: "The label key that the selector applies to.",
-										Optional:    true,
+										Type:         schema.TypeString,
+										Description:  "The label key that the selector applies to, e.g. `topology.kubernetes.io/zone`.",
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateQualifiedName,
 									},
 									"values": {
 										Type:        schema.TypeSet,
 										Description: "An array of string values. One value must match the label to be selected.",
-										Optional:    true,
-										Elem:        &schema.Schema{Type: schema.TypeString},
+										Required:    true,
+										ForceNew:    true,
+										MinItems:    1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
 									},
 								},
 							},
@@ -249,6 +249,56 @@ func TestAccKubernetesStorageClassV1_allowedTopologies_minikube(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesStorageClassV1_allowedTopologiesInvalid(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesStorageClassV1Config_allowedTopologiesExpression(name, "topology/kubernetes.io/zone", `["us-west1-a"]`),
+				ExpectError: regexp.MustCompile(`allowed_topologies\.0\.match_label_expressions\.0\.key`),
+			},
+			{
+				Config:      testAccKubernetesStorageClassV1Config_allowedTopologiesExpression(name, "topology.kubernetes.io/zone", "[]"),
+				ExpectError: regexp.MustCompile(`Not enough list items`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesStorageClassV1_reclaimPolicy(t *testing.T) {
+	var conf1, conf2 api.StorageClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_storage_class_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesStorageClassV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStorageClassV1Config_reclaimPolicy(name, "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStorageClassV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "reclaim_policy", "Delete"),
+				),
+			},
+			{
+				Config: testAccKubernetesStorageClassV1Config_reclaimPolicy(name, "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStorageClassV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "reclaim_policy", "Retain"),
+					testAccCheckKubernetesStorageClassV1ForceNew(&conf1, &conf2, true),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesStorageClassV1_generatedName(t *testing.T) {
 	var conf api.StorageClass
 	prefix := "tf-acc-test-gen-"
@@ -474,3 +524,47 @@ func testAccKubernetesStorageClassV1Config_allowedTopologies(name, provisioner s
 }
 `, name, provisioner)
 }
+
+func testAccKubernetesStorageClassV1Config_allowedTopologiesExpression(name, key, values string) string {
+	return fmt.Sprintf(`resource "kubernetes_storage_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  storage_provisioner = "kubernetes.io/no-provisioner"
+  allowed_topologies {
+    match_label_expressions {
+      key    = %q
+      values = %s
+    }
+  }
+}
+`, name, key, values)
+}
+
+func testAccKubernetesStorageClassV1Config_reclaimPolicy(name, reclaimPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_storage_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  storage_provisioner = "kubernetes.io/no-provisioner"
+  reclaim_policy      = %q
+}
+`, name, reclaimPolicy)
+}
+
+func testAccCheckKubernetesStorageClassV1ForceNew(old, new *api.StorageClass, wantNew bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if wantNew {
+			if old.ObjectMeta.UID == new.ObjectMeta.UID {
+				return fmt.Errorf("Expecting forced replacement")
+			}
+		} else {
+			if old.ObjectMeta.UID != new.ObjectMeta.UID {
+				return fmt.Errorf("Unexpected forced replacement")
+			}
+		}
+		return nil
+	}
+}
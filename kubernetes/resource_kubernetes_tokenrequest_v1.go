@@ -6,6 +6,7 @@ package kubernetes
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,6 +61,7 @@ func resourceKubernetesTokenRequestV1Create(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[INFO] Creating new TokenRequest: %#v", request)
+	requestedAt := time.Now()
 	out, err := conn.CoreV1().ServiceAccounts(metadata.Namespace).CreateToken(ctx, saName, &request, metav1.CreateOptions{})
 	if err != nil {
 		return diag.FromErr(err)
@@ -74,7 +76,15 @@ func resourceKubernetesTokenRequestV1Create(ctx context.Context, d *schema.Resou
 	log.Printf("[INFO] Submitted new TokenRequest: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	return resourceKubernetesTokenRequestV1Read(ctx, d, meta)
+	diags := resourceKubernetesTokenRequestV1Read(ctx, d, meta)
+	if w := tokenRequestV1ExpirationWarning(spec.ExpirationSeconds, requestedAt, out.Status.ExpirationTimestamp.Time); w != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Token expires earlier than requested",
+			Detail:   w,
+		})
+	}
+	return diags
 }
 
 func resourceKubernetesTokenRequestV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -258,7 +258,7 @@ func resourceKubernetesValidatingWebhookConfigurationV1Update(ctx context.Contex
 	}
 
 	name := d.Id()
-	log.Printf("[INFO] Updating ValidatingWebhookCoßnfiguration %q: %v", name, string(data))
+	log.Printf("[INFO] Updating ValidatingWebhookConfiguration %q: %v", name, string(data))
 
 	res := &admissionregistrationv1.ValidatingWebhookConfiguration{}
 
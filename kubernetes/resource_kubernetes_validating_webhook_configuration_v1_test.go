@@ -256,7 +256,7 @@ func testAccKubernetesValidatingWebhookConfigurationV1Config_modified(name strin
         name      = "example-service"
       }
 
-      ca_bundle = "test"
+      ca_bundle = %q
     }
 
     rule {
@@ -285,7 +285,7 @@ func testAccKubernetesValidatingWebhookConfigurationV1Config_modified(name strin
     timeout_seconds = 5
   }
 }
-`, name, name)
+`, name, name, testAccClusterTrustBundleCertificate)
 }
 
 func testAccKubernetesValidatingWebhookConfigurationV1Config_without_rules(name string) string {
@@ -311,7 +311,7 @@ func testAccKubernetesValidatingWebhookConfigurationV1Config_without_rules(name
         name      = "example-service"
       }
 
-      ca_bundle = "test"
+      ca_bundle = %q
     }
 
     object_selector {
@@ -324,5 +324,5 @@ func testAccKubernetesValidatingWebhookConfigurationV1Config_without_rules(name
     timeout_seconds = 5
   }
 }
-`, name, name)
+`, name, name, testAccClusterTrustBundleCertificate)
 }
@@ -250,7 +250,7 @@ func testAccKubernetesValidatingWebhookConfigurationV1Beta1Config_modified(name
         name      = "example-service"
       }
 
-      ca_bundle = "test"
+      ca_bundle = %q
     }
 
     rule {
@@ -279,7 +279,7 @@ func testAccKubernetesValidatingWebhookConfigurationV1Beta1Config_modified(name
     timeout_seconds = 5
   }
 }
-`, name, name)
+`, name, name, testAccClusterTrustBundleCertificate)
 }
 
 func skipIfNotAdmissionRegistrationV1(t *testing.T) {
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+)
+
+func resourceKubernetesVerticalPodAutoscalerV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Vertical Pod Autoscaler automatically adjusts the CPU and memory requests of the pods of a workload based on their observed usage. It requires the `autoscaling.k8s.io/v1` custom resource definitions of the Kubernetes autoscaler to be installed in the cluster.",
+		CreateContext: resourceKubernetesVerticalPodAutoscalerV1Create,
+		ReadContext:   resourceKubernetesVerticalPodAutoscalerV1Read,
+		UpdateContext: resourceKubernetesVerticalPodAutoscalerV1Update,
+		DeleteContext: resourceKubernetesVerticalPodAutoscalerV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("vertical pod autoscaler", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Specification of the vertical pod autoscaler.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_ref": {
+							Type:        schema.TypeList,
+							Description: "Reference to the controller managing the set of pods for the autoscaler to control, e.g. a Deployment or a StatefulSet.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_version": {
+										Type:        schema.TypeString,
+										Description: "API version of the referent.",
+										Optional:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of the referent, e.g. `Deployment`.",
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the referent.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"update_policy": {
+							Type:        schema.TypeList,
+							Description: "Describes the rules on how changes are applied to the pods.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"update_mode": {
+										Type:         schema.TypeString,
+										Description:  "Controls when the autoscaler applies changes to the pod resources. One of `Off`, `Initial`, `Recreate` or `Auto`. Defaults to `Auto`.",
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"Off", "Initial", "Recreate", "Auto"}, false),
+									},
+								},
+							},
+						},
+						"resource_policy": {
+							Type:        schema.TypeList,
+							Description: "Controls how the autoscaler computes recommended resources.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_policies": {
+										Type:        schema.TypeList,
+										Description: "Resource policies of individual containers.",
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"container_name": {
+													Type:        schema.TypeString,
+													Description: "Name of the container the policy applies to, or `*` for the default policy of all containers that have no policy of their own.",
+													Optional:    true,
+												},
+												"mode": {
+													Type:         schema.TypeString,
+													Description:  "Whether autoscaler is enabled for the container. One of `Auto` or `Off`. Defaults to `Auto`.",
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Auto", "Off"}, false),
+												},
+												"min_allowed": {
+													Type:             schema.TypeMap,
+													Description:      "Minimal amount of resources that will be recommended for the container.",
+													Optional:         true,
+													Elem:             schema.TypeString,
+													ValidateFunc:     validateResourceList,
+													DiffSuppressFunc: suppressEquivalentResourceQuantity,
+												},
+												"max_allowed": {
+													Type:             schema.TypeMap,
+													Description:      "Maximum amount of resources that will be recommended for the container.",
+													Optional:         true,
+													Elem:             schema.TypeString,
+													ValidateFunc:     validateResourceList,
+													DiffSuppressFunc: suppressEquivalentResourceQuantity,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": verticalPodAutoscalerV1StatusSchema(),
+		},
+	}
+}
+
+func verticalPodAutoscalerV1StatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Most recently observed status of the autoscaler.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"recommendation": {
+					Type:        schema.TypeList,
+					Description: "Most recently computed amount of resources recommended by the autoscaler for the controlled pods.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_recommendations": {
+								Type:        schema.TypeList,
+								Description: "Resources recommended by the autoscaler for each container.",
+								Computed:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_name": {
+											Type:        schema.TypeString,
+											Description: "Name of the container.",
+											Computed:    true,
+										},
+										"target": {
+											Type:        schema.TypeMap,
+											Description: "Recommended amount of resources.",
+											Computed:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+										"lower_bound": {
+											Type:        schema.TypeMap,
+											Description: "Minimum recommended amount of resources.",
+											Computed:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+										"upper_bound": {
+											Type:        schema.TypeMap,
+											Description: "Maximum recommended amount of resources.",
+											Computed:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+										"uncapped_target": {
+											Type:        schema.TypeMap,
+											Description: "Most recent recommended resources target computed by the autoscaler, ignoring the `min_allowed` and `max_allowed` bounds of the container policy.",
+											Computed:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func checkVerticalPodAutoscalerV1Available(dc discovery.DiscoveryInterface) error {
+	gv := verticalPodAutoscalerV1GroupVersionResource.GroupVersion()
+	err := discovery.ServerSupportsVersion(dc, gv)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return fmt.Errorf("the %s API is not available in this cluster; vertical pod autoscalers require the VerticalPodAutoscaler custom resource definitions of the Kubernetes autoscaler to be installed: %s", gv, err)
+	}
+	return nil
+}
+
+func resourceKubernetesVerticalPodAutoscalerV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkVerticalPodAutoscalerV1Available(dc); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandVerticalPodAutoscalerV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpa := verticalPodAutoscalerV1{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+	obj, err := verticalPodAutoscalerV1ToUnstructured(&vpa)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new vertical pod autoscaler: %#v", vpa)
+	out, err := conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(metadata.Namespace).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create vertical pod autoscaler: %s", err)
+	}
+	log.Printf("[INFO] Submitted new vertical pod autoscaler: %#v", out)
+	d.SetId(buildId(metav1.ObjectMeta{Namespace: out.GetNamespace(), Name: out.GetName()}))
+
+	return resourceKubernetesVerticalPodAutoscalerV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVerticalPodAutoscalerV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesVerticalPodAutoscalerV1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading vertical pod autoscaler %s", name)
+	out, err := conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	vpa, err := verticalPodAutoscalerV1FromUnstructured(out)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received vertical pod autoscaler: %#v", vpa)
+
+	err = d.Set("metadata", flattenMetadata(vpa.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenVerticalPodAutoscalerV1Spec(vpa.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenVerticalPodAutoscalerV1Status(vpa.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVerticalPodAutoscalerV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		spec, err := expandVerticalPodAutoscalerV1Spec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating vertical pod autoscaler %q: %v", name, string(data))
+	out, err := conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update vertical pod autoscaler: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated vertical pod autoscaler: %#v", out)
+
+	return resourceKubernetesVerticalPodAutoscalerV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVerticalPodAutoscalerV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting vertical pod autoscaler: %#v", name)
+	err = conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Vertical pod autoscaler %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesVerticalPodAutoscalerV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking vertical pod autoscaler %s", name)
+	_, err = conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
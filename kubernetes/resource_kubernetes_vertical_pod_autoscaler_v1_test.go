@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesVerticalPodAutoscalerV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_vertical_pod_autoscaler_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNoVerticalPodAutoscalerV1(t)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesVerticalPodAutoscalerV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVerticalPodAutoscalerV1Config_basic(name, "Off", "100m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesVerticalPodAutoscalerV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.target_ref.0.api_version", "apps/v1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.target_ref.0.kind", "Deployment"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.target_ref.0.name", "TerraformAccTest"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.update_policy.0.update_mode", "Off"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.0.container_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.0.mode", "Auto"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.0.min_allowed.cpu", "100m"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.0.max_allowed.memory", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesVerticalPodAutoscalerV1Config_basic(name, "Initial", "0.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesVerticalPodAutoscalerV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.update_policy.0.update_mode", "Initial"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.resource_policy.0.container_policies.0.min_allowed.cpu", "200m"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesVerticalPodAutoscalerV1_invalidMode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesVerticalPodAutoscalerV1Config_basic("tf-acc-test", "Sometimes", "100m"),
+				ExpectError: regexp.MustCompile(`expected spec\.0\.update_policy\.0\.update_mode to be one of`),
+			},
+		},
+	})
+}
+
+func skipIfNoVerticalPodAutoscalerV1(t *testing.T) {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkVerticalPodAutoscalerV1Available(dc); err != nil {
+		t.Skipf("The Kubernetes endpoint does not serve vertical pod autoscalers - skipping: %s", err)
+	}
+}
+
+func testAccCheckKubernetesVerticalPodAutoscalerV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_vertical_pod_autoscaler_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.GetNamespace() == namespace && resp.GetName() == name {
+				return fmt.Errorf("Vertical pod autoscaler still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesVerticalPodAutoscalerV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.Resource(verticalPodAutoscalerV1GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesVerticalPodAutoscalerV1Config_basic(name, updateMode, minCPU string) string {
+	return fmt.Sprintf(`resource "kubernetes_vertical_pod_autoscaler_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    target_ref {
+      api_version = "apps/v1"
+      kind        = "Deployment"
+      name        = "TerraformAccTest"
+    }
+
+    update_policy {
+      update_mode = %q
+    }
+
+    resource_policy {
+      container_policies {
+        container_name = "*"
+        mode           = "Auto"
+        min_allowed = {
+          cpu    = %q
+          memory = "64Mi"
+        }
+        max_allowed = {
+          cpu    = "1"
+          memory = "1Gi"
+        }
+      }
+    }
+  }
+}
+`, name, updateMode, minCPU)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// watchStatusFunc reports whether the given object reached the state that is
+// waited for and, if it did not, what it is still waiting for. An error is
+// returned when the state cannot be reached without further changes.
+type watchStatusFunc func(obj runtime.Object) (bool, string, error)
+
+// listWatchByName returns a ListWatch limited to the object with the given name.
+func listWatchByName(name string, list func(metav1.ListOptions) (runtime.Object, error), watchFunc func(metav1.ListOptions) (watch.Interface, error)) *cache.ListWatch {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return list(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return watchFunc(options)
+		},
+	}
+}
+
+// waitForRollout watches the object described by desc, e.g. "Deployment
+// default/app", until statusFunc reports its rollout as complete, the timeout
+// expires or ctx is cancelled. The last observed object is returned so that
+// callers can add details to the error.
+func waitForRollout(ctx context.Context, lw cache.ListerWatcher, objType runtime.Object, desc string, timeout time.Duration, statusFunc watchStatusFunc) (runtime.Object, error) {
+	return waitForObject(ctx, lw, objType, desc, "roll out", timeout, func(obj runtime.Object) (bool, string, error) {
+		if obj == nil {
+			return false, "", fmt.Errorf("%s was deleted while waiting for rollout", desc)
+		}
+		return statusFunc(obj)
+	})
+}
+
+// waitForObject watches the object described by desc until statusFunc reports
+// it as done, the timeout expires or ctx is cancelled. statusFunc is called
+// with a nil object when the object is deleted, including when it is already
+// gone before the watch starts. goal describes what is waited for in error
+// messages, e.g. "complete".
+func waitForObject(ctx context.Context, lw cache.ListerWatcher, objType runtime.Object, desc, goal string, timeout time.Duration, statusFunc watchStatusFunc) (runtime.Object, error) {
+	wctx, cancel := watchtools.ContextWithOptionalTimeout(ctx, timeout)
+	defer cancel()
+
+	var last runtime.Object
+	status := "Waiting for the first status update"
+	// Without this, no event would ever arrive for an object that is missing
+	// from the initial list, and the wait would only end with the timeout.
+	missing := func(store cache.Store) (bool, error) {
+		if len(store.List()) > 0 {
+			return false, nil
+		}
+		done, _, err := statusFunc(nil)
+		return done, err
+	}
+	_, err := watchtools.UntilWithSync(wctx, lw, objType, missing, func(event watch.Event) (bool, error) {
+		var obj runtime.Object
+		switch event.Type {
+		case watch.Deleted:
+		case watch.Added, watch.Modified:
+			obj = event.Object
+			last = obj
+		default:
+			return false, nil
+		}
+		done, s, err := statusFunc(obj)
+		if err != nil {
+			return false, err
+		}
+		if !done {
+			status = s
+			log.Printf("[DEBUG] %s: %s", desc, status)
+		}
+		return done, nil
+	})
+	if err == nil {
+		return last, nil
+	}
+
+	if ctx.Err() != nil {
+		return last, fmt.Errorf("Interrupted while waiting for %s to %s: %s", desc, goal, status)
+	}
+	if wait.Interrupted(err) || errors.Is(err, context.DeadlineExceeded) {
+		return last, fmt.Errorf("Timed out waiting for %s to %s: %s", desc, goal, status)
+	}
+	return last, err
+}
+
+// withPodProblems adds the problems of the pods matched by selector to err.
+func withPodProblems(ctx context.Context, conn *kubernetes.Clientset, namespace string, selector *metav1.LabelSelector, err error) error {
+	s, serr := metav1.LabelSelectorAsSelector(selector)
+	if serr != nil {
+		log.Printf("[DEBUG] Invalid pod selector: %s", serr)
+		return err
+	}
+	pods, perr := conn.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: s.String(),
+	})
+	if perr != nil {
+		log.Printf("[DEBUG] Failed to list pods in namespace %s: %s", namespace, perr)
+		return err
+	}
+	problems := podRolloutProblems(pods.Items)
+	if len(problems) == 0 {
+		return err
+	}
+	return fmt.Errorf("%s\n\n%s", err, strings.Join(problems, "\n"))
+}
+
+// podRolloutProblems describes why the given pods are not ready, e.g. image
+// pull failures, crashing containers or failing readiness probes.
+func podRolloutProblems(pods []corev1.Pod) []string {
+	var problems []string
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse {
+				problems = append(problems, fmt.Sprintf("Pod %s cannot be scheduled: %s", pod.Name, c.Message))
+			}
+		}
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			switch {
+			case cs.State.Waiting != nil:
+				switch cs.State.Waiting.Reason {
+				case "", "ContainerCreating", "PodInitializing":
+					continue
+				}
+				msg := fmt.Sprintf("Container %q of pod %s is waiting: %s", cs.Name, pod.Name, cs.State.Waiting.Reason)
+				if cs.State.Waiting.Message != "" {
+					msg += ": " + cs.State.Waiting.Message
+				}
+				problems = append(problems, msg)
+			case cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0:
+				problems = append(problems, fmt.Sprintf("Container %q of pod %s terminated with exit code %d: %s", cs.Name, pod.Name, cs.State.Terminated.ExitCode, cs.State.Terminated.Reason))
+			case cs.State.Running != nil && !cs.Ready:
+				problems = append(problems, fmt.Sprintf("Container %q of pod %s is running but not ready, check its readiness probe", cs.Name, pod.Name))
+			}
+		}
+	}
+	return problems
+}
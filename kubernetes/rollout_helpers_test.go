@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestPodRolloutProblems(t *testing.T) {
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "image"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "readiness"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "creating"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "healthy"},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		`Container "app" of pod image is waiting: ImagePullBackOff: Back-off pulling image`,
+		`Container "app" of pod readiness is running but not ready, check its readiness probe`,
+	}
+	if diff := cmp.Diff(expected, podRolloutProblems(pods)); diff != "" {
+		t.Fatalf("unexpected problems (-want +got):\n%s", diff)
+	}
+}
+
+func TestWaitForObjectMissing(t *testing.T) {
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return &batchv1.JobList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}
+
+	t.Run("done", func(t *testing.T) {
+		_, err := waitForObject(context.Background(), lw, &batchv1.Job{}, "Job default/test", "complete", 10*time.Second, func(obj runtime.Object) (bool, string, error) {
+			return obj == nil, "Waiting for the job to finish", nil
+		})
+		if err != nil {
+			t.Fatalf("expected the wait to end for a missing object, got: %s", err)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		_, err := waitForObject(context.Background(), lw, &batchv1.Job{}, "Job default/test", "complete", 10*time.Second, func(obj runtime.Object) (bool, string, error) {
+			if obj == nil {
+				return false, "", fmt.Errorf("Job default/test was deleted")
+			}
+			return false, "Waiting for the job to finish", nil
+		})
+		if err == nil || err.Error() != "Job default/test was deleted" {
+			t.Fatalf("expected the deletion error, got: %v", err)
+		}
+	})
+}
@@ -34,17 +34,18 @@ func backendSpecFieldsV1(description string) *schema.Schema {
 							},
 							"name": {
 								Type:        schema.TypeString,
-								Description: "The name of the User to bind to.",
+								Description: "The name of the resource being referenced.",
 								Required:    true,
 							},
 						},
 					},
-					Description: "Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. If resource is specified, a service.Name and service.Port must not be specified.",
+					Description: "Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. Exactly one of `service` or `resource` must be specified.",
 				},
 				"service": {
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
+					Type:        schema.TypeList,
+					Description: "Service references a service as a backend. Exactly one of `service` or `resource` must be specified.",
+					MaxItems:    1,
+					Optional:    true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
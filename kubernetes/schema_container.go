@@ -125,6 +125,23 @@ func resourcesFieldV1(isUpdatable bool) map[string]*schema.Schema {
 			},
 			DiffSuppressFunc: suppressEquivalentResourceQuantity,
 		},
+		"claims": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Claims lists the names of resources, defined in `resource_claim` of the pod spec, that are used by this container. Requires the `dynamic_resource_allocation` provider experiment and Kubernetes 1.26 or later.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "Name must match the name of one entry in `resource_claim` of the pod spec where this field is used. It makes that resource available inside the container.",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -174,7 +191,7 @@ func seccompProfileField(isUpdatable bool) map[string]*schema.Schema {
 			Optional:    true,
 			ForceNew:    !isUpdatable,
 			Default:     "",
-			Description: "Localhost Profile indicates a profile defined in a file on the node should be used. The profile must be preconfigured on the node to work.",
+			Description: "Localhost Profile indicates a profile defined in a file on the node should be used. The profile must be preconfigured on the node to work. Must be set if, and only if, type is Localhost.",
 		},
 		"type": {
 			Type:     schema.TypeString,
@@ -241,7 +258,12 @@ func volumeMountFields() map[string]*schema.Schema {
 		"sub_path": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: `Path within the volume from which the container's volume should be mounted. Defaults to "" (volume's root).`,
+			Description: `Path within the volume from which the container's volume should be mounted. Defaults to "" (volume's root). Mutually exclusive with sub_path_expr.`,
+		},
+		"sub_path_expr": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `Expanded path within the volume from which the container's volume should be mounted. Behaves similarly to sub_path but environment variable references $(VAR_NAME) are expanded using the container's environment. Defaults to "" (volume's root). Mutually exclusive with sub_path.`,
 		},
 		"mount_propagation": {
 			Type:         schema.TypeString,
@@ -256,9 +278,10 @@ func volumeMountFields() map[string]*schema.Schema {
 func volumeDeviceFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"device_path": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Path within the container at which the volume device should be attached. For example '/dev/xvda'.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAbsolutePath,
+			Description:  "Path within the container at which the volume device should be attached. For example '/dev/xvda'.",
 		},
 		"name": {
 			Type:        schema.TypeString,
@@ -602,6 +625,37 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 				Schema: resourcesFieldV1(isUpdatable),
 			},
 		},
+		"resize_policy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Resources resize policy for the container. Only cpu and memory can be resized in place, which `kubernetes_pod_v1` does on Kubernetes 1.33 or later, replacing the pod on older clusters. Cannot be updated. More info: https://kubernetes.io/docs/tasks/configure-pod-container/resize-container-resources/",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Name of the resource to which this resource resize policy applies. Supported values: cpu, memory.",
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.ResourceCPU),
+							string(api.ResourceMemory),
+						}, false),
+					},
+					"restart_policy": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Restart policy to apply when the specified resource is resized. NotRequired resizes the resource without restarting the container, RestartContainer restarts the container to apply the new value.",
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.NotRequired),
+							string(api.RestartContainer),
+						}, false),
+					},
+				},
+			},
+		},
 		"security_context": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -615,7 +669,7 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 			Optional:    true,
 			MaxItems:    1,
 			ForceNew:    !isUpdatable,
-			Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is an alpha feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+			Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
 			Elem:        probeSchema(),
 		},
 		"stdin": {
@@ -633,11 +687,12 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 			Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF.",
 		},
 		"termination_message_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			ForceNew:    !isUpdatable,
-			Default:     "/dev/termination-log",
-			Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Defaults to /dev/termination-log. Cannot be updated.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     !isUpdatable,
+			Default:      "/dev/termination-log",
+			ValidateFunc: validateAbsolutePath,
+			Description:  "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Must be an absolute path. Defaults to /dev/termination-log. Cannot be updated.",
 		},
 		"termination_message_policy": {
 			Type:     schema.TypeString,
@@ -685,11 +740,42 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 	return s
 }
 
+// initContainerFields returns the schema of an init container. It is a container
+// plus the restart policy that turns it into a sidecar container.
+func initContainerFields(isUpdatable bool) map[string]*schema.Schema {
+	s := containerFields(isUpdatable)
+	s["restart_policy"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     !isUpdatable,
+		ValidateFunc: validation.StringInSlice([]string{"", string(api.ContainerRestartPolicyAlways)}, false),
+		Description:  "Restart policy of the init container. The only allowed value is `Always`, which makes the init container a sidecar container that is started before and keeps running alongside the containers of the pod. If not set, the init container runs to completion before the next one is started. Requires Kubernetes 1.29 or later. More info: https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/",
+	}
+	return s
+}
+
+// ephemeralContainerFields returns the schema of an ephemeral container. It is
+// a container without the fields the API server rejects for ephemeral containers,
+// plus the name of the container whose namespaces it joins.
+func ephemeralContainerFields() map[string]*schema.Schema {
+	s := containerFields(true)
+	for _, k := range []string{"lifecycle", "liveness_probe", "port", "readiness_probe", "resize_policy", "resources", "startup_probe"} {
+		delete(s, k)
+	}
+	s["target_container_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the container from the pod spec that this ephemeral container targets. The ephemeral container is run in the namespaces (IPC, PID, etc) of this container. If not set, the ephemeral container uses the namespaces configured in the pod spec.",
+	}
+	return s
+}
+
 func probeSchema() *schema.Resource {
 	h := lifecycleHandlerFields()
 	h["grpc"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Optional:    true,
+		MaxItems:    1,
 		Description: "GRPC specifies an action involving a GRPC port.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
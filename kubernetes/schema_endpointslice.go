@@ -98,7 +98,7 @@ func schemaEndpointSliceSubsetPorts() *schema.Resource {
 					if err != nil {
 						return []string{}, []error{fmt.Errorf("%s is not a valid integer", key)}
 					}
-					return validateNonNegativeInteger(v, key)
+					return validatePortNum(v, key)
 				},
 			},
 			"protocol": {
@@ -113,14 +113,15 @@ func schemaEndpointSliceSubsetPorts() *schema.Resource {
 				}, false),
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Description: "name represents the name of this port. All ports in an EndpointSlice must have a unique name.",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "name represents the name of this port. All ports in an EndpointSlice must have a unique name.",
+				Optional:     true,
+				ValidateFunc: validateDNS1123Label,
 			},
 			"app_protocol": {
 				Type:        schema.TypeString,
 				Description: "The application protocol for this port. This is used as a hint for implementations to offer richer behavior for protocols that they understand.",
-				Required:    true,
+				Optional:    true,
 			},
 		},
 	}
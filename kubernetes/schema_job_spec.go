@@ -71,7 +71,7 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			ForceNew:     true,
 			Default:      1,
 			ValidateFunc: validatePositiveInteger,
-			Description:  "Specifies the desired number of successfully finished pods the job should be run with. Setting to nil means that the success of any pod signals the success of all pods, and allows parallelism to have any positive value. Setting to 1 means that parallelism is limited to 1 and the success of that pod signals the success of the job. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
+			Description:  "Specifies the desired number of successfully finished pods the job should be run with. Setting to nil means that the success of any pod signals the success of all pods, and allows parallelism to have any positive value. Setting to 1 means that parallelism is limited to 1 and the success of that pod signals the success of the job. It must be set when `completion_mode` is `Indexed`, which the default of 1 satisfies. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
 		},
 		"completion_mode": {
 			Type:     schema.TypeString,
@@ -82,7 +82,7 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 				string(batchv1.IndexedCompletion),
 				string(batchv1.NonIndexedCompletion),
 			}, false),
-			Description: "Specifies how Pod completions are tracked. It can be `NonIndexed` (default) or `Indexed`. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#completion-mode",
+			Description: "Specifies how Pod completions are tracked. It can be `NonIndexed` (default) or `Indexed`. In `Indexed` mode, each pod gets a completion index from 0 to `completions` - 1, available to its containers in the `JOB_COMPLETION_INDEX` environment variable, and the job completes when there is one successfully completed pod for each index. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#completion-mode",
 		},
 		"manual_selector": {
 			Type:        schema.TypeBool,
@@ -105,43 +105,65 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			ValidateFunc: validateNonNegativeInteger,
 			Description:  "Specifies the maximum desired number of pods the job should run at any given time. The actual number of pods running in steady state will be less than this number when ((.spec.completions - .status.successful) < .spec.parallelism), i.e. when the work left to do is less than max parallelism. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
 		},
+		"suspend": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies whether the job controller should create pods or not. If a job is created with suspend set to true, no pods are created by the job controller. If a job is suspended after creation, i.e. the flag goes from false to true, the job controller will delete all active pods associated with the job. Resuming a job resets its `active_deadline_seconds` timer. Defaults to false. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#suspending-a-job",
+		},
 		"pod_failure_policy": {
 			Type:        schema.TypeList,
 			Optional:    true,
 			ForceNew:    true,
 			MaxItems:    1,
-			Description: "Specifies the maximum desired number of pods the job should run at any given time. The actual number of pods running in steady state will be less than this number when ((.spec.completions - .status.successful) < .spec.parallelism), i.e. when the work left to do is less than max parallelism. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
+			Description: "Specifies the policy of handling failed pods. In particular, it allows to specify the set of actions and conditions which need to be satisfied to take the associated action. If empty, the default behaviour applies - the counter of failed pods, represented by the job's `failed` status, is incremented and it is checked against the `backoff_limit`. Requires Kubernetes 1.26 or later. More info: https://kubernetes.io/docs/concepts/workloads/controllers/job/#pod-failure-policy",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"rule": {
 						Type:        schema.TypeList,
-						Description: "A label query over volumes to consider for binding.",
+						Description: "A list of pod failure policy rules. The rules are evaluated in order. Once a rule matches a pod failure, the remaining rules are ignored. At most 20 rules are allowed.",
 						Required:    true,
+						MaxItems:    20,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"action": {
-									Type:     schema.TypeString,
-									Optional: true,
+									Type:        schema.TypeString,
+									Description: "Specifies the action taken on a pod failure when the requirements are satisfied. `FailJob` marks the job as failed and terminates all running pods. `FailIndex` marks the index of the pod as failed, which requires `backoff_limit_per_index`. `Ignore` does not increment the counter towards the `backoff_limit` and creates a replacement pod. `Count` handles the pod failure in the default way, incrementing the counter towards the `backoff_limit`.",
+									Required:    true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(batchv1.PodFailurePolicyActionFailJob),
+										string(batchv1.PodFailurePolicyActionFailIndex),
+										string(batchv1.PodFailurePolicyActionIgnore),
+										string(batchv1.PodFailurePolicyActionCount),
+									}, false),
 								},
 								"on_exit_codes": {
-									Type:     schema.TypeList,
-									Optional: true,
-									MaxItems: 1,
+									Type:        schema.TypeList,
+									Description: "Represents the requirement on the container exit codes. Only one of `on_exit_codes` and `on_pod_condition` can be set in a rule.",
+									Optional:    true,
+									MaxItems:    1,
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"container_name": {
-												Type:     schema.TypeString,
-												Optional: true,
+												Type:        schema.TypeString,
+												Description: "Restricts the check for exit codes to the container with the specified name. When empty, the rule applies to all containers.",
+												Optional:    true,
 											},
 											"operator": {
-												Type:     schema.TypeString,
-												Optional: true,
+												Type:        schema.TypeString,
+												Description: "Represents the relationship between the container exit codes and the specified values. `In` matches when the exit code of at least one container is in the set of values, `NotIn` when it is not. Containers completed with success (exit code 0) are excluded from the check.",
+												Required:    true,
+												ValidateFunc: validation.StringInSlice([]string{
+													string(batchv1.PodFailurePolicyOnExitCodesOpIn),
+													string(batchv1.PodFailurePolicyOnExitCodesOpNotIn),
+												}, false),
 											},
 											"values": {
-												Type:     schema.TypeList,
-												Required: true,
-												MinItems: 1,
-												MaxItems: 255,
+												Type:        schema.TypeList,
+												Description: "Specifies the set of values. The value 0 cannot be used. At most 255 values are allowed.",
+												Required:    true,
+												MinItems:    1,
+												MaxItems:    255,
 												Elem: &schema.Schema{Type: schema.TypeInt,
 													ValidateFunc: validation.IntNotInSlice([]int{0})},
 											},
@@ -149,18 +171,22 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 									},
 								},
 								"on_pod_condition": {
-									Type:     schema.TypeList,
-									Optional: true,
+									Type:        schema.TypeList,
+									Description: "Represents the requirement on the pod conditions. The requirement is a list of pod condition patterns, satisfied if at least one pattern matches an actual pod condition. Only one of `on_exit_codes` and `on_pod_condition` can be set in a rule.",
+									Optional:    true,
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"status": {
-												Type:     schema.TypeString,
-												Optional: true,
-												Default:  "True",
+												Type:         schema.TypeString,
+												Description:  "Specifies the required pod condition status. To match a pod condition it is required that the specified status equals the pod condition status. Defaults to `True`.",
+												Optional:     true,
+												Default:      "True",
+												ValidateFunc: validation.StringInSlice([]string{"True", "False", "Unknown"}, false),
 											},
 											"type": {
-												Type:     schema.TypeString,
-												Optional: true,
+												Type:        schema.TypeString,
+												Description: "Specifies the required pod condition type, e.g. `DisruptionTarget`. To match a pod condition it is required that the specified type equals the pod condition type.",
+												Required:    true,
 											},
 										},
 									},
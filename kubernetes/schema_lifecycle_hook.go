@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const lifecycleHookEventCreate = "create"
+
+// lifecycleHookSchema returns the schema of the commands run in a pod of the
+// resource, described by podDesc, after it has been created.
+func lifecycleHookSchema(podDesc string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: fmt.Sprintf("Commands run once the resource has been created, e.g. to seed a database. Hooks are run in %s. They run in the given order, and when one of them fails the resource is deleted again and the apply fails. Hooks are not run again when they are changed later on.", podDesc),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on_event": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Event on which the hook is run. The only supported value is `create`.",
+					ValidateFunc: validation.StringInSlice([]string{lifecycleHookEventCreate}, false),
+				},
+				"command": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Command to execute in the container. The command is not run in a shell, so call one explicitly to use shell features. The hook fails when the command exits with a non-zero status.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"container": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the container to run the command in. Defaults to the first container of the pod.",
+				},
+				"timeout_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validatePositiveInteger,
+					Description:  "Number of seconds after which the hook fails, including the time spent waiting for a running pod. Defaults to 60 seconds.",
+				},
+			},
+		},
+	}
+}
@@ -90,6 +90,30 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 			Computed:    true,
 			ForceNew:    true,
 		},
+		// The API server copies data_source to data_source_ref and vice versa
+		// when only one of them is set, so both are also computed.
+		"data_source": {
+			Type:        schema.TypeList,
+			Description: "The source of the data to populate the volume with, e.g. an existing persistent volume claim to clone or a volume snapshot. Cannot be used together with `data_source_ref`. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#volume-cloning",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(false),
+			},
+		},
+		"data_source_ref": {
+			Type:        schema.TypeList,
+			Description: "The object to populate the volume with data from. Unlike `data_source`, it can reference any object from a non-empty API group and, when the cross-namespace volume data source feature is enabled, an object in another namespace. Cannot be used together with `data_source`.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(true),
+			},
+		},
 		"volume_mode": {
 			Type:        schema.TypeString,
 			Description: "Defines what type of volume is required by the claim.",
@@ -103,3 +127,35 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 		},
 	}
 }
+
+func persistentVolumeClaimDataSourceFields(withNamespace bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"api_group": {
+			Type:        schema.TypeString,
+			Description: "The group for the resource being referenced. If not specified, the kind must be in the core API group. Must be `snapshot.storage.k8s.io` when `kind` is `VolumeSnapshot`.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "The kind of resource being referenced, e.g. `PersistentVolumeClaim` or `VolumeSnapshot`.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of resource being referenced.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	if withNamespace {
+		s["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The namespace of resource being referenced. Requires the cross-namespace volume data source feature to be enabled in the cluster.",
+			Optional:    true,
+			ForceNew:    true,
+		}
+	}
+	return s
+}
@@ -52,14 +52,73 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Optional:    true,
 			Computed:    true,
+			ForceNew:    !isUpdatable,
 			Description: "If specified, all readiness gates will be evaluated for pod readiness. A pod is ready when all its containers are ready AND all conditions specified in the readiness gates have status equal to \"True\" More info: https://git.k8s.io/enhancements/keps/sig-network/0007-pod-ready%2B%2B.md",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"condition_type": {
-						Type:        schema.TypeString,
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						Description:  "refers to a condition in the pod's condition list with matching type.",
+						ValidateFunc: validateQualifiedName,
+					},
+				},
+			},
+		},
+		"scheduling_gate": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "If specified, the pod is not scheduled until all scheduling gates are removed, e.g. by an external controller. Scheduling gates can only be set when the pod is created, and can only be removed afterwards. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-scheduling-readiness/",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Name of the scheduling gate. Each scheduling gate must have a unique name.",
+						ValidateFunc: validateQualifiedName,
+					},
+				},
+			},
+		},
+		"resource_claim": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Resource claims that must be allocated and reserved before the pod is allowed to start. The resources are made available to the containers which consume them by name through `claims` in their `resources`. Requires the `dynamic_resource_allocation` provider experiment and Kubernetes 1.26 or later. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						Description:  "Name uniquely identifies this resource claim inside the pod.",
+						ValidateFunc: validateName,
+					},
+					"source": {
+						Type:        schema.TypeList,
 						Required:    true,
 						ForceNew:    !isUpdatable,
-						Description: "refers to a condition in the pod's condition list with matching type.",
+						MaxItems:    1,
+						Description: "Source describes where to find the resource claim.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"resource_claim_name": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ForceNew:     !isUpdatable,
+									Description:  "The name of a ResourceClaim object in the same namespace as this pod. Exactly one of `resource_claim_name` and `resource_claim_template_name` must be set.",
+									ValidateFunc: validateName,
+								},
+								"resource_claim_template_name": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ForceNew:     !isUpdatable,
+									Description:  "The name of a ResourceClaimTemplate object in the same namespace as this pod. The template is used to create a new ResourceClaim, which is bound to this pod. Exactly one of `resource_claim_name` and `resource_claim_template_name` must be set.",
+									ValidateFunc: validateName,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -70,7 +129,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/",
 			Elem: &schema.Resource{
-				Schema: containerFields(isUpdatable),
+				Schema: initContainerFields(isUpdatable),
 			},
 		},
 		"dns_policy": {
@@ -158,7 +217,10 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 						Required:    true,
 						ForceNew:    !isUpdatable,
 						Description: "Hostnames for the IP address.",
-						Elem:        &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateHostname,
+						},
 					},
 					"ip": {
 						Type:         schema.TypeString,
@@ -256,6 +318,28 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "RuntimeClassName is a feature for selecting the container runtime configuration. The container runtime configuration is used to run a Pod's containers. More info: https://kubernetes.io/docs/concepts/containers/runtime-class",
 		},
+		"overhead": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Description: "Overhead represents the resource overhead associated with running a pod for a given RuntimeClass. It is normally set by the RuntimeClass admission controller from the overhead of the RuntimeClass named by `runtime_class_name`, and must match it when set explicitly. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-overhead/",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pod_fixed": {
+						Type:             schema.TypeMap,
+						Optional:         true,
+						Computed:         true,
+						ForceNew:         !isUpdatable,
+						Description:      "PodFixed represents the fixed resource overhead associated with running a pod.",
+						Elem:             &schema.Schema{Type: schema.TypeString},
+						ValidateFunc:     validateResourceList,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+				},
+			},
+		},
 		"priority_class_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -386,10 +470,11 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"name": {
-									Type:        schema.TypeString,
-									Description: "Name of a property to set.",
-									Required:    true,
-									ForceNew:    !isUpdatable,
+									Type:         schema.TypeString,
+									Description:  "Name of a property to set. Each sysctl can only be set once.",
+									Required:     true,
+									ForceNew:     !isUpdatable,
+									ValidateFunc: validation.StringIsNotEmpty,
 								},
 								"value": {
 									Type:        schema.TypeString,
@@ -445,50 +530,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Optional:    true,
 			Description: "If specified, the pod's toleration. Optional: Defaults to empty",
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"effect": {
-						Type:        schema.TypeString,
-						Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{
-							string(corev1.TaintEffectNoSchedule),
-							string(corev1.TaintEffectPreferNoSchedule),
-							string(corev1.TaintEffectNoExecute),
-						}, false),
-					},
-					"key": {
-						Type:        schema.TypeString,
-						Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-					"operator": {
-						Type:        schema.TypeString,
-						Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
-						Default:     string(corev1.TolerationOpEqual),
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{
-							string(corev1.TolerationOpExists),
-							string(corev1.TolerationOpEqual),
-						}, false),
-					},
-					"toleration_seconds": {
-						// Use TypeString to allow an "unspecified" value,
-						Type:         schema.TypeString,
-						Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
-						Optional:     true,
-						ForceNew:     !isUpdatable,
-						ValidateFunc: validateTypeStringNullableInt,
-					},
-					"value": {
-						Type:        schema.TypeString,
-						Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-				},
+				Schema: tolerationFields(isUpdatable),
 			},
 		},
 		"topology_spread_constraint": {
@@ -511,7 +553,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 						Description:  "describes the degree to which pods may be unevenly distributed.",
 						Optional:     true,
 						Default:      1,
-						ValidateFunc: validation.IntAtLeast(1),
+						ValidateFunc: validatePositiveInteger,
 					},
 					"min_domains": {
 						Type:         schema.TypeInt,
@@ -522,14 +564,14 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 					},
 					"node_affinity_policy": {
 						Type:         schema.TypeString,
-						Description:  "indicates how we will treat Pod's nodeAffinity/nodeSelector when calculating pod topology spread skew.",
+						Description:  "indicates how we will treat Pod's nodeAffinity/nodeSelector when calculating pod topology spread skew. Requires Kubernetes 1.26 or later.",
 						Optional:     true,
 						ForceNew:     !isUpdatable,
 						ValidateFunc: validation.StringInSlice([]string{string(corev1.NodeInclusionPolicyHonor), string(corev1.NodeInclusionPolicyIgnore)}, false),
 					},
 					"node_taints_policy": {
 						Type:         schema.TypeString,
-						Description:  "indicates how we will treat node taints when calculating pod topology spread skew.",
+						Description:  "indicates how we will treat node taints when calculating pod topology spread skew. Requires Kubernetes 1.26 or later.",
 						Optional:     true,
 						ForceNew:     !isUpdatable,
 						ValidateFunc: validation.StringInSlice([]string{string(corev1.NodeInclusionPolicyHonor), string(corev1.NodeInclusionPolicyIgnore)}, false),
@@ -777,7 +819,7 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 
 	v["ephemeral"] = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Represents an ephemeral volume that is handled by a normal storage driver. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes",
+		Description: "Represents an ephemeral volume that is handled by a normal storage driver. The persistent volume claim created for it is deleted together with the pod, along with its data unless the reclaim policy of the bound volume retains it. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes",
 		Optional:    true,
 		MaxItems:    1,
 		Elem: &schema.Resource{
@@ -925,7 +967,7 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 				},
 				"sources": {
 					Type:        schema.TypeList,
-					Description: "Source of the volume to project in the directory.",
+					Description: "Source of the volume to project in the directory. Each source should set exactly one of `secret`, `config_map`, `downward_api` or `service_account_token`; a source that sets several of them is read back as separate sources, which causes a diff.",
 					Required:    true,
 					MinItems:    1,
 					Elem: &schema.Resource{
@@ -1169,9 +1211,10 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
-								Type:        schema.TypeString,
-								Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
-								Optional:    true,
+								Type:         schema.TypeString,
+								Description:  "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 					},
@@ -1183,3 +1226,50 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 		Schema: v,
 	}
 }
+
+func tolerationFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"effect": {
+			Type:        schema.TypeString,
+			Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(corev1.TaintEffectNoSchedule),
+				string(corev1.TaintEffectPreferNoSchedule),
+				string(corev1.TaintEffectNoExecute),
+			}, false),
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+		},
+		"operator": {
+			Type:        schema.TypeString,
+			Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+			Default:     string(corev1.TolerationOpEqual),
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(corev1.TolerationOpExists),
+				string(corev1.TolerationOpEqual),
+			}, false),
+		},
+		"toleration_seconds": {
+			// Use TypeString to allow an "unspecified" value,
+			Type:         schema.TypeString,
+			Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
+			Optional:     true,
+			ForceNew:     !isUpdatable,
+			ValidateFunc: validateTypeStringNullableInt,
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+		},
+	}
+}
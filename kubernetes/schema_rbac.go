@@ -128,3 +128,49 @@ func policyRuleSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+func roleRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_groups": {
+			Type:        schema.TypeSet,
+			Description: "Name of the APIGroup that contains the resources",
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"resources": {
+			Type:        schema.TypeSet,
+			Description: "List of resources that the rule applies to",
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"resource_names": {
+			Type:        schema.TypeSet,
+			Description: "White list of names that the rule applies to",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"verbs": {
+			Type:        schema.TypeSet,
+			Description: "List of Verbs that apply to ALL the ResourceKinds and AttributeRestrictions contained in this rule",
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+	}
+}
+
+func clusterRoleAggregationRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_role_selectors": {
+			Type:        schema.TypeList,
+			Description: "A list of selectors which will be used to find ClusterRoles and create the rules.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+	}
+}
@@ -46,6 +46,7 @@ func persistentVolumeSourceSchema() *schema.Resource {
 				"node_stage_secret_ref":         commonVolumeSourcesSecretRef("A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodeStageVolume and NodeStageVolume and NodeUnstageVolume calls."),
 				"node_publish_secret_ref":       commonVolumeSourcesSecretRef("A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls."),
 				"controller_expand_secret_ref":  commonVolumeSourcesSecretRef("A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI ControllerExpandVolume call."),
+				"node_expand_secret_ref":        commonVolumeSourcesSecretRef("A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodeExpandVolume call."),
 			},
 		},
 	}
@@ -603,9 +604,10 @@ func commonVolumeSourcesSecretRef(description string) *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
-					Type:        schema.TypeString,
-					Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
-					Optional:    true,
+					Type:         schema.TypeString,
+					Description:  "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 				"namespace": {
 					Type:        schema.TypeString,
@@ -42,9 +42,11 @@ func webhookClientConfigFields() map[string]*schema.Schema {
 	apiDoc := admissionregistrationv1.WebhookClientConfig{}.SwaggerDoc()
 	return map[string]*schema.Schema{
 		"ca_bundle": {
-			Type:        schema.TypeString,
-			Description: apiDoc["caBundle"],
-			Optional:    true,
+			Type:             schema.TypeString,
+			Description:      apiDoc["caBundle"],
+			Optional:         true,
+			ValidateFunc:     validateCABundle,
+			DiffSuppressFunc: suppressInjectedCABundle,
 		},
 		"service": {
 			Type:        schema.TypeList,
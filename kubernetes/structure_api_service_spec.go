@@ -4,6 +4,8 @@
 package kubernetes
 
 import (
+	"encoding/base64"
+
 	v1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/utils/ptr"
 )
@@ -13,7 +15,7 @@ import (
 func flattenAPIServiceV1Spec(in v1.APIServiceSpec) []interface{} {
 	att := make(map[string]interface{})
 
-	att["ca_bundle"] = string(in.CABundle)
+	att["ca_bundle"] = base64.StdEncoding.EncodeToString(in.CABundle)
 	att["group"] = in.Group
 	att["group_priority_minimum"] = in.GroupPriorityMinimum
 	att["insecure_skip_tls_verify"] = in.InsecureSkipTLSVerify
@@ -34,6 +36,23 @@ func flattenAPIServiceV1Spec(in v1.APIServiceSpec) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenAPIServiceV1Status(in v1.APIServiceStatus) []interface{} {
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, condition := range in.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":                 string(condition.Type),
+			"status":               string(condition.Status),
+			"last_transition_time": condition.LastTransitionTime.String(),
+			"reason":               condition.Reason,
+			"message":              condition.Message,
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"conditions": conditions,
+	}}
+}
+
 // Expanders
 
 func expandAPIServiceV1Spec(l []interface{}) v1.APIServiceSpec {
@@ -44,7 +63,10 @@ func expandAPIServiceV1Spec(l []interface{}) v1.APIServiceSpec {
 	obj := v1.APIServiceSpec{}
 
 	if v, ok := in["ca_bundle"].(string); ok {
-		obj.CABundle = []byte(v)
+		b, err := base64.StdEncoding.DecodeString(v)
+		if err == nil {
+			obj.CABundle = b
+		}
 	}
 	if v, ok := in["group"].(string); ok {
 		obj.Group = v
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestAPIServiceV1SpecCABundleRoundTrip(t *testing.T) {
+	caBundle := base64.StdEncoding.EncodeToString([]byte(testAccClusterTrustBundleCertificate))
+	spec := []interface{}{map[string]interface{}{
+		"ca_bundle":                caBundle,
+		"group":                    "metrics.k8s.io",
+		"group_priority_minimum":   100,
+		"insecure_skip_tls_verify": false,
+		"version":                  "v1beta1",
+		"version_priority":         100,
+	}}
+
+	obj := expandAPIServiceV1Spec(spec)
+	if string(obj.CABundle) != testAccClusterTrustBundleCertificate {
+		t.Fatalf("expected the CA bundle to be sent decoded, got: %q", obj.CABundle)
+	}
+	flattened := flattenAPIServiceV1Spec(obj)
+	if got := flattened[0].(map[string]interface{})["ca_bundle"]; got != caBundle {
+		t.Fatalf("expected the CA bundle %q to survive a round trip, got: %q", caBundle, got)
+	}
+}
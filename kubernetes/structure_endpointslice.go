@@ -91,7 +91,7 @@ func expandEndpointSlicePorts(in []interface{}) []api.EndpointPort {
 		if v, ok := portCfg["protocol"].(v1.Protocol); ok {
 			r.Protocol = &v
 		}
-		if v, ok := portCfg["app_protocol"].(string); ok {
+		if v, ok := portCfg["app_protocol"].(string); ok && v != "" {
 			r.AppProtocol = ptr.To(v)
 		}
 		ports[i] = r
@@ -4,6 +4,10 @@
 package kubernetes
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 )
@@ -251,6 +255,56 @@ func expandIngressV1Backend(l []interface{}) *networking.IngressBackend {
 	return obj
 }
 
+// ingressV1TLSHostWarnings warns about TLS hosts that no rule serves. A host is
+// served by a rule with the same host or one that either host covers with a
+// wildcard.
+// Ingresses with a default backend or a rule without a host serve every host,
+// so no warnings are raised for them.
+func ingressV1TLSHostWarnings(spec networking.IngressSpec) diag.Diagnostics {
+	if spec.DefaultBackend != nil && (spec.DefaultBackend.Service != nil || spec.DefaultBackend.Resource != nil) {
+		return nil
+	}
+	for _, rule := range spec.Rules {
+		if rule.Host == "" {
+			return nil
+		}
+	}
+	var diags diag.Diagnostics
+	for _, tls := range spec.TLS {
+		for _, host := range tls.Hosts {
+			if ingressV1HostHasRule(host, spec.Rules) {
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "TLS host without a matching rule",
+				Detail:   fmt.Sprintf("The TLS host %q does not match the host of any rule, so requests to it are not routed to any backend. Add a rule for the host or remove it from the tls block.", host),
+			})
+		}
+	}
+	return diags
+}
+
+func ingressV1HostHasRule(host string, rules []networking.IngressRule) bool {
+	for _, rule := range rules {
+		if rule.Host == host || ingressV1WildcardHostMatches(rule.Host, host) || ingressV1WildcardHostMatches(host, rule.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// ingressV1WildcardHostMatches reports whether the wildcard host pattern, e.g.
+// "*.example.com", covers host. As in Kubernetes, the wildcard matches exactly
+// one DNS label.
+func ingressV1WildcardHostMatches(pattern, host string) bool {
+	if !strings.HasPrefix(pattern, "*.") {
+		return false
+	}
+	label, suffix, found := strings.Cut(host, ".")
+	return found && label != "" && label != "*" && suffix == pattern[2:]
+}
+
 func expandIngressV1TLS(l []interface{}) []networking.IngressTLS {
 	if len(l) == 0 {
 		return nil
@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	networking "k8s.io/api/networking/v1"
@@ -89,3 +90,64 @@ func TestFlattenIngressV1Rule(t *testing.T) {
 		}
 	}
 }
+
+func TestIngressV1TLSHostWarnings(t *testing.T) {
+	backend := &networking.IngressBackend{Service: &networking.IngressServiceBackend{Name: "app"}}
+	cases := map[string]struct {
+		spec     networking.IngressSpec
+		warnings []string
+	}{
+		"matching rule": {
+			spec: networking.IngressSpec{
+				TLS:   []networking.IngressTLS{{Hosts: []string{"app.example.com"}}},
+				Rules: []networking.IngressRule{{Host: "app.example.com"}},
+			},
+		},
+		"wildcard rule": {
+			spec: networking.IngressSpec{
+				TLS:   []networking.IngressTLS{{Hosts: []string{"app.example.com", "a.b.example.com"}}},
+				Rules: []networking.IngressRule{{Host: "*.example.com"}},
+			},
+			warnings: []string{"a.b.example.com"},
+		},
+		"wildcard tls host": {
+			spec: networking.IngressSpec{
+				TLS:   []networking.IngressTLS{{Hosts: []string{"*.example.com"}}},
+				Rules: []networking.IngressRule{{Host: "app.example.com"}},
+			},
+		},
+		"no matching rule": {
+			spec: networking.IngressSpec{
+				TLS:   []networking.IngressTLS{{Hosts: []string{"app.example.com", "api.example.com"}}},
+				Rules: []networking.IngressRule{{Host: "app.example.com"}},
+			},
+			warnings: []string{"api.example.com"},
+		},
+		"rule without host": {
+			spec: networking.IngressSpec{
+				TLS:   []networking.IngressTLS{{Hosts: []string{"api.example.com"}}},
+				Rules: []networking.IngressRule{{Host: "app.example.com"}, {}},
+			},
+		},
+		"default backend": {
+			spec: networking.IngressSpec{
+				DefaultBackend: backend,
+				TLS:            []networking.IngressTLS{{Hosts: []string{"api.example.com"}}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := ingressV1TLSHostWarnings(tc.spec)
+			if len(diags) != len(tc.warnings) {
+				t.Fatalf("expected %d warnings, got: %#v", len(tc.warnings), diags)
+			}
+			for i, w := range tc.warnings {
+				if !strings.Contains(diags[i].Detail, w) {
+					t.Fatalf("expected warning %d to mention %q, got: %s", i, w, diags[i].Detail)
+				}
+			}
+		})
+	}
+}
@@ -51,6 +51,10 @@ func flattenJobV1Spec(in batchv1.JobSpec, d *schema.ResourceData, meta interface
 		att["pod_failure_policy"] = flattenPodFailurePolicy(in.PodFailurePolicy)
 	}
 
+	if in.Suspend != nil {
+		att["suspend"] = *in.Suspend
+	}
+
 	if in.Selector != nil {
 		att["selector"] = flattenLabelSelector(in.Selector)
 	}
@@ -70,6 +74,15 @@ func flattenJobV1Spec(in batchv1.JobSpec, d *schema.ResourceData, meta interface
 	return []interface{}{att}, nil
 }
 
+func flattenJobV1Status(in batchv1.JobStatus) []interface{} {
+	att := map[string]interface{}{
+		"active":    int(in.Active),
+		"succeeded": int(in.Succeeded),
+		"failed":    int(in.Failed),
+	}
+	return []interface{}{att}
+}
+
 func expandJobV1Spec(j []interface{}) (batchv1.JobSpec, error) {
 	obj := batchv1.JobSpec{}
 
@@ -116,6 +129,10 @@ func expandJobV1Spec(j []interface{}) (batchv1.JobSpec, error) {
 		obj.PodFailurePolicy = expandPodFailurePolicy(v)
 	}
 
+	if v, ok := in["suspend"].(bool); ok {
+		obj.Suspend = ptr.To(v)
+	}
+
 	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
 		obj.Selector = expandLabelSelector(v)
 	}
@@ -420,6 +420,9 @@ func flattenCSIPersistentVolumeSource(in *v1.CSIPersistentVolumeSource) []interf
 	if in.ControllerExpandSecretRef != nil {
 		att["controller_expand_secret_ref"] = flattenSecretReference(in.ControllerExpandSecretRef)
 	}
+	if in.NodeExpandSecretRef != nil {
+		att["node_expand_secret_ref"] = flattenSecretReference(in.NodeExpandSecretRef)
+	}
 	if in.ControllerPublishSecretRef != nil {
 		att["controller_publish_secret_ref"] = flattenSecretReference(in.ControllerPublishSecretRef)
 	}
@@ -1066,6 +1069,9 @@ func expandCSIPersistentDiskVolumeSource(l []interface{}) *v1.CSIPersistentVolum
 	if v, ok := in["controller_expand_secret_ref"].([]interface{}); ok && len(v) > 0 {
 		obj.ControllerExpandSecretRef = expandSecretReference(v)
 	}
+	if v, ok := in["node_expand_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.NodeExpandSecretRef = expandSecretReference(v)
+	}
 	return obj
 }
 
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+)
+
+const (
+	podSecurityVersionLatest = "latest"
+
+	podSecurityLevelPrivileged = "privileged"
+	podSecurityLevelBaseline   = "baseline"
+	podSecurityLevelRestricted = "restricted"
+
+	podSecurityLabelPrefix = "pod-security.kubernetes.io/"
+
+	pspSeccompAllowedProfilesAnnotation = "seccomp.security.alpha.kubernetes.io/allowedProfileNames"
+)
+
+var podSecurityModes = []string{"enforce", "warn", "audit"}
+
+// podSecurityBaselineCapabilities are the capabilities the baseline Pod
+// Security Standard allows containers to add.
+var podSecurityBaselineCapabilities = map[v1.Capability]bool{
+	"AUDIT_WRITE":      true,
+	"CHOWN":            true,
+	"DAC_OVERRIDE":     true,
+	"FOWNER":           true,
+	"FSETID":           true,
+	"KILL":             true,
+	"MKNOD":            true,
+	"NET_BIND_SERVICE": true,
+	"SETFCAP":          true,
+	"SETGID":           true,
+	"SETPCAP":          true,
+	"SETUID":           true,
+	"SYS_CHROOT":       true,
+}
+
+// podSecurityRestrictedVolumes are the volume types the restricted Pod
+// Security Standard allows.
+var podSecurityRestrictedVolumes = map[policy.FSType]bool{
+	policy.ConfigMap:             true,
+	policy.CSI:                   true,
+	policy.DownwardAPI:           true,
+	policy.EmptyDir:              true,
+	policy.Ephemeral:             true,
+	policy.PersistentVolumeClaim: true,
+	policy.Projected:             true,
+	policy.Secret:                true,
+}
+
+// suggestPodSecurityLevel returns the most restrictive Pod Security Standard
+// level that admits every pod the PodSecurityPolicy admits, along with the
+// reasons a more restrictive level was ruled out.
+func suggestPodSecurityLevel(psp policy.PodSecurityPolicy) (string, []string) {
+	spec := psp.Spec
+	var baseline, restricted []string
+
+	if spec.Privileged {
+		baseline = append(baseline, "allows privileged containers")
+	}
+	if spec.HostNetwork || spec.HostPID || spec.HostIPC {
+		baseline = append(baseline, "allows host namespaces")
+	}
+	if len(spec.HostPorts) > 0 {
+		baseline = append(baseline, "allows host ports")
+	}
+	for _, c := range spec.AllowedCapabilities {
+		if c == policy.AllowAllCapabilities || !podSecurityBaselineCapabilities[c] {
+			baseline = append(baseline, fmt.Sprintf("allows adding capability %s", c))
+		} else if c != "NET_BIND_SERVICE" {
+			restricted = append(restricted, fmt.Sprintf("allows adding capability %s", c))
+		}
+	}
+	for _, fs := range spec.Volumes {
+		if fs == policy.All || fs == policy.HostPath {
+			baseline = append(baseline, fmt.Sprintf("allows %s volumes", fs))
+		} else if !podSecurityRestrictedVolumes[fs] {
+			restricted = append(restricted, fmt.Sprintf("allows %s volumes", fs))
+		}
+	}
+	for _, t := range spec.AllowedProcMountTypes {
+		if t == v1.UnmaskedProcMount {
+			baseline = append(baseline, "allows unmasked proc mounts")
+		}
+	}
+	if len(spec.AllowedUnsafeSysctls) > 0 {
+		baseline = append(baseline, "allows unsafe sysctls")
+	}
+	if spec.SELinux.Rule == policy.SELinuxStrategyRunAsAny {
+		baseline = append(baseline, "allows any SELinux options")
+	}
+
+	seccomp := psp.Annotations[pspSeccompAllowedProfilesAnnotation]
+	switch {
+	case seccomp == "":
+		restricted = append(restricted, "does not require a seccomp profile")
+	case strings.Contains(seccomp, "*") || strings.Contains(seccomp, "unconfined"):
+		baseline = append(baseline, "allows unconfined seccomp profiles")
+	}
+	if spec.AllowPrivilegeEscalation == nil || *spec.AllowPrivilegeEscalation {
+		restricted = append(restricted, "allows privilege escalation")
+	}
+	if !pspRequiresNonRootUser(spec.RunAsUser) {
+		restricted = append(restricted, "allows running as root")
+	}
+	if !pspDropsAllCapabilities(spec.RequiredDropCapabilities) {
+		restricted = append(restricted, "does not require dropping all capabilities")
+	}
+
+	if len(baseline) > 0 {
+		return podSecurityLevelPrivileged, baseline
+	}
+	if len(restricted) > 0 {
+		return podSecurityLevelBaseline, restricted
+	}
+	return podSecurityLevelRestricted, []string{}
+}
+
+func pspRequiresNonRootUser(s policy.RunAsUserStrategyOptions) bool {
+	switch s.Rule {
+	case policy.RunAsUserStrategyMustRunAsNonRoot:
+		return true
+	case policy.RunAsUserStrategyMustRunAs:
+		if len(s.Ranges) == 0 {
+			return false
+		}
+		for _, r := range s.Ranges {
+			if r.Min < 1 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func pspDropsAllCapabilities(caps []v1.Capability) bool {
+	for _, c := range caps {
+		if strings.EqualFold(string(c), "ALL") {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenPodSecurityMigration(psp policy.PodSecurityPolicy) []interface{} {
+	level, reasons := suggestPodSecurityLevel(psp)
+	return []interface{}{
+		map[string]interface{}{
+			"level":   level,
+			"reasons": reasons,
+		},
+	}
+}
+
+// expandPodSecurityAdmissionProfile returns the pod security labels of a
+// namespace for the profile block.
+func expandPodSecurityAdmissionProfile(l []interface{}) map[string]string {
+	labels := make(map[string]string)
+	if len(l) == 0 || l[0] == nil {
+		return labels
+	}
+	in := l[0].(map[string]interface{})
+	for _, mode := range podSecurityModes {
+		m, ok := in[mode].([]interface{})
+		if !ok || len(m) == 0 || m[0] == nil {
+			continue
+		}
+		p := m[0].(map[string]interface{})
+		key := podSecurityLabelPrefix + mode
+		labels[key] = p["level"].(string)
+		labels[key+"-version"] = p["version"].(string)
+	}
+	return labels
+}
+
+// patchPodSecurityAdmissionProfile returns the labels of a merge patch from
+// the old to the new profile block. Only labels of modes that changed are
+// included, and labels of removed modes map to nil, so that labels of other
+// modes set outside of Terraform are left alone.
+func patchPodSecurityAdmissionProfile(oldProfile, newProfile []interface{}) map[string]interface{} {
+	oldLabels := expandPodSecurityAdmissionProfile(oldProfile)
+	newLabels := expandPodSecurityAdmissionProfile(newProfile)
+	labels := make(map[string]interface{})
+	for k := range oldLabels {
+		if _, ok := newLabels[k]; !ok {
+			labels[k] = nil
+		}
+	}
+	for k, v := range newLabels {
+		if ov, ok := oldLabels[k]; !ok || ov != v {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+func flattenPodSecurityAdmissionProfile(labels map[string]string) []interface{} {
+	att := make(map[string]interface{})
+	for _, mode := range podSecurityModes {
+		key := podSecurityLabelPrefix + mode
+		level, ok := labels[key]
+		if !ok {
+			continue
+		}
+		version := labels[key+"-version"]
+		if version == "" {
+			version = podSecurityVersionLatest
+		}
+		att[mode] = []interface{}{
+			map[string]interface{}{
+				"level":   level,
+				"version": version,
+			},
+		}
+	}
+	if len(att) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{att}
+}
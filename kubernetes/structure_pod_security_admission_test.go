@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestSuggestPodSecurityLevel(t *testing.T) {
+	restrictedSpec := func() policy.PodSecurityPolicySpec {
+		return policy.PodSecurityPolicySpec{
+			AllowPrivilegeEscalation: ptr.To(false),
+			RequiredDropCapabilities: []v1.Capability{"ALL"},
+			Volumes:                  []policy.FSType{policy.ConfigMap, policy.Secret, policy.PersistentVolumeClaim},
+			RunAsUser:                policy.RunAsUserStrategyOptions{Rule: policy.RunAsUserStrategyMustRunAsNonRoot},
+			SELinux:                  policy.SELinuxStrategyOptions{Rule: policy.SELinuxStrategyMustRunAs},
+		}
+	}
+	seccomp := map[string]string{pspSeccompAllowedProfilesAnnotation: "runtime/default"}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		spec        func(*policy.PodSecurityPolicySpec)
+		level       string
+		reasons     []string
+	}{
+		"restricted": {
+			annotations: seccomp,
+			level:       podSecurityLevelRestricted,
+			reasons:     []string{},
+		},
+		"no seccomp profile": {
+			level:   podSecurityLevelBaseline,
+			reasons: []string{"does not require a seccomp profile"},
+		},
+		"root and host path volumes": {
+			annotations: seccomp,
+			spec: func(s *policy.PodSecurityPolicySpec) {
+				s.RunAsUser = policy.RunAsUserStrategyOptions{Rule: policy.RunAsUserStrategyRunAsAny}
+				s.Volumes = append(s.Volumes, policy.HostPath)
+			},
+			level:   podSecurityLevelPrivileged,
+			reasons: []string{"allows hostPath volumes"},
+		},
+		"root user range and nfs volumes": {
+			annotations: seccomp,
+			spec: func(s *policy.PodSecurityPolicySpec) {
+				s.RunAsUser = policy.RunAsUserStrategyOptions{Rule: policy.RunAsUserStrategyMustRunAs, Ranges: []policy.IDRange{{Min: 0, Max: 1000}}}
+				s.Volumes = append(s.Volumes, policy.NFS)
+			},
+			level:   podSecurityLevelBaseline,
+			reasons: []string{"allows nfs volumes", "allows running as root"},
+		},
+		"privileged": {
+			annotations: map[string]string{pspSeccompAllowedProfilesAnnotation: "*"},
+			spec: func(s *policy.PodSecurityPolicySpec) {
+				s.Privileged = true
+				s.HostNetwork = true
+				s.AllowedCapabilities = []v1.Capability{"NET_ADMIN", "CHOWN"}
+				s.SELinux = policy.SELinuxStrategyOptions{Rule: policy.SELinuxStrategyRunAsAny}
+			},
+			level: podSecurityLevelPrivileged,
+			reasons: []string{
+				"allows privileged containers",
+				"allows host namespaces",
+				"allows adding capability NET_ADMIN",
+				"allows any SELinux options",
+				"allows unconfined seccomp profiles",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := restrictedSpec()
+			if tc.spec != nil {
+				tc.spec(&spec)
+			}
+			psp := policy.PodSecurityPolicy{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       spec,
+			}
+			level, reasons := suggestPodSecurityLevel(psp)
+			if level != tc.level {
+				t.Fatalf("expected level %q, got %q (reasons: %v)", tc.level, level, reasons)
+			}
+			if diff := cmp.Diff(tc.reasons, reasons); diff != "" {
+				t.Fatalf("unexpected reasons (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodSecurityAdmissionProfileRoundTrip(t *testing.T) {
+	profile := []interface{}{
+		map[string]interface{}{
+			"enforce": []interface{}{map[string]interface{}{"level": "baseline", "version": "latest"}},
+			"audit":   []interface{}{map[string]interface{}{"level": "restricted", "version": "v1.30"}},
+		},
+	}
+	expectedLabels := map[string]string{
+		"pod-security.kubernetes.io/enforce":         "baseline",
+		"pod-security.kubernetes.io/enforce-version": "latest",
+		"pod-security.kubernetes.io/audit":           "restricted",
+		"pod-security.kubernetes.io/audit-version":   "v1.30",
+	}
+	labels := expandPodSecurityAdmissionProfile(profile)
+	if diff := cmp.Diff(expectedLabels, labels); diff != "" {
+		t.Fatalf("unexpected labels (-want +got):\n%s", diff)
+	}
+
+	nsLabels := map[string]string{"kubernetes.io/metadata.name": "test"}
+	for k, v := range labels {
+		nsLabels[k] = v
+	}
+	if diff := cmp.Diff(profile, flattenPodSecurityAdmissionProfile(nsLabels)); diff != "" {
+		t.Fatalf("unexpected profile (-want +got):\n%s", diff)
+	}
+
+	// a level without a version label uses the latest version
+	flattened := flattenPodSecurityAdmissionProfile(map[string]string{"pod-security.kubernetes.io/warn": "privileged"})
+	expected := []interface{}{
+		map[string]interface{}{
+			"warn": []interface{}{map[string]interface{}{"level": "privileged", "version": "latest"}},
+		},
+	}
+	if diff := cmp.Diff(expected, flattened); diff != "" {
+		t.Fatalf("unexpected profile (-want +got):\n%s", diff)
+	}
+}
+
+func TestPatchPodSecurityAdmissionProfile(t *testing.T) {
+	oldProfile := []interface{}{
+		map[string]interface{}{
+			"enforce": []interface{}{map[string]interface{}{"level": "baseline", "version": "latest"}},
+			"audit":   []interface{}{map[string]interface{}{"level": "restricted", "version": "latest"}},
+		},
+	}
+	newProfile := []interface{}{
+		map[string]interface{}{
+			"enforce": []interface{}{map[string]interface{}{"level": "restricted", "version": "latest"}},
+		},
+	}
+	// the unchanged enforce version and the warn mode, which was never set,
+	// are left alone
+	expected := map[string]interface{}{
+		"pod-security.kubernetes.io/enforce":       "restricted",
+		"pod-security.kubernetes.io/audit":         nil,
+		"pod-security.kubernetes.io/audit-version": nil,
+	}
+	if diff := cmp.Diff(expected, patchPodSecurityAdmissionProfile(oldProfile, newProfile)); diff != "" {
+		t.Fatalf("unexpected patch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]interface{}{}, patchPodSecurityAdmissionProfile(newProfile, newProfile)); diff != "" {
+		t.Fatalf("unexpected patch (-want +got):\n%s", diff)
+	}
+}
@@ -4,9 +4,17 @@
 package kubernetes
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
@@ -140,6 +148,29 @@ func flattenLoadBalancerStatus(in v1.LoadBalancerStatus) []interface{} {
 	}
 }
 
+// flattenEndpointSliceReadyAddresses returns the sorted, distinct addresses of
+// the ready endpoints in slices. An endpoint without a ready condition is
+// treated as ready, as the API documents.
+func flattenEndpointSliceReadyAddresses(slices []discoveryv1.EndpointSlice) []string {
+	seen := make(map[string]bool)
+	out := []string{}
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, addr := range endpoint.Addresses {
+				if !seen[addr] {
+					seen[addr] = true
+					out = append(out, addr)
+				}
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
 // Expanders
 
 func expandServicePort(l []interface{}, removeNodePort bool) []v1.ServicePort {
@@ -429,3 +460,113 @@ func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData, kv *
 	}
 	return ops
 }
+
+// loadBalancerAnnotationConflict describes two cloud provider annotations of a
+// LoadBalancer service that cannot be used together. An empty value matches
+// any value of the annotation.
+type loadBalancerAnnotationConflict struct {
+	annotation         string
+	value              string
+	conflictAnnotation string
+	conflictValue      string
+	reason             string
+}
+
+// loadBalancerAnnotationConflicts holds the known conflicting load balancer
+// annotations of AWS, GCP and Azure.
+var loadBalancerAnnotationConflicts = []loadBalancerAnnotationConflict{
+	{
+		annotation:         "service.beta.kubernetes.io/aws-load-balancer-internal",
+		value:              "true",
+		conflictAnnotation: "service.beta.kubernetes.io/aws-load-balancer-scheme",
+		conflictValue:      "internet-facing",
+		reason:             "a load balancer cannot be both internal and internet-facing",
+	},
+	{
+		annotation:         "service.beta.kubernetes.io/aws-load-balancer-type",
+		value:              "nlb",
+		conflictAnnotation: "service.beta.kubernetes.io/aws-load-balancer-nlb-target-type",
+		reason:             "the target type is only honoured by the AWS Load Balancer Controller, which requires aws-load-balancer-type to be \"external\"",
+	},
+	{
+		annotation:         "networking.gke.io/load-balancer-type",
+		value:              "Internal",
+		conflictAnnotation: "cloud.google.com/l4-rbs",
+		conflictValue:      "enabled",
+		reason:             "backend service-based load balancers are external",
+	},
+	{
+		annotation:         "service.beta.kubernetes.io/azure-load-balancer-internal",
+		value:              "true",
+		conflictAnnotation: "service.beta.kubernetes.io/azure-pip-name",
+		reason:             "internal load balancers do not use a public IP",
+	},
+	{
+		annotation:         "service.beta.kubernetes.io/azure-load-balancer-internal",
+		value:              "true",
+		conflictAnnotation: "service.beta.kubernetes.io/azure-dns-label-name",
+		reason:             "DNS labels are set on the public IP, which internal load balancers do not use",
+	},
+}
+
+// serviceLoadBalancerAnnotationWarnings warns about known conflicting cloud
+// provider annotations set on a service of type LoadBalancer. Cloud providers
+// silently ignore one of them or fail to provision the load balancer.
+func serviceLoadBalancerAnnotationWarnings(metadata metav1.ObjectMeta, spec v1.ServiceSpec) diag.Diagnostics {
+	if spec.Type != v1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	matches := func(annotation, value string) bool {
+		v, ok := metadata.Annotations[annotation]
+		return ok && (value == "" || strings.EqualFold(v, value))
+	}
+	var diags diag.Diagnostics
+	for _, c := range loadBalancerAnnotationConflicts {
+		if !matches(c.annotation, c.value) || !matches(c.conflictAnnotation, c.conflictValue) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Conflicting load balancer annotations",
+			Detail:   fmt.Sprintf("The service sets both the %q and %q annotations, which conflict: %s. Remove one of them.", c.annotation, c.conflictAnnotation, c.reason),
+		})
+	}
+	return diags
+}
+
+// serviceHealthCheckNodePortWarnings warns when a LoadBalancer service routes
+// external traffic to node-local endpoints without a health_check_node_port.
+// The API server then allocates one, which is read back into the state.
+func serviceHealthCheckNodePortWarnings(spec v1.ServiceSpec) diag.Diagnostics {
+	if spec.Type != v1.ServiceTypeLoadBalancer || spec.ExternalTrafficPolicy != v1.ServiceExternalTrafficPolicyLocal || spec.HealthCheckNodePort != 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Health check node port will be allocated",
+		Detail:   fmt.Sprintf("The service is of type %q and sets external_traffic_policy to %q, but does not set health_check_node_port. Kubernetes allocates a node port for the load balancer health checks, which has to be allowed by firewalls between the load balancer and the nodes. Set health_check_node_port to choose the port.", v1.ServiceTypeLoadBalancer, v1.ServiceExternalTrafficPolicyLocal),
+	}}
+}
+
+// serviceNodePortDeallocationWarnings warns when allocate_load_balancer_node_ports
+// of a LoadBalancer service is turned off while its ports still have node ports.
+// Kubernetes does not release node ports that are already allocated.
+func serviceNodePortDeallocationWarnings(spec v1.ServiceSpec) diag.Diagnostics {
+	if spec.Type != v1.ServiceTypeLoadBalancer || spec.AllocateLoadBalancerNodePorts == nil || *spec.AllocateLoadBalancerNodePorts {
+		return nil
+	}
+	ports := make([]string, 0)
+	for _, p := range spec.Ports {
+		if p.NodePort != 0 {
+			ports = append(ports, strconv.Itoa(int(p.NodePort)))
+		}
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Allocated node ports are not released",
+		Detail:   fmt.Sprintf("allocate_load_balancer_node_ports was set to false, but Kubernetes does not release the node ports %s that are already allocated to the service. They keep accepting traffic until the service is recreated, which disrupts existing connections.", strings.Join(ports, ", ")),
+	}}
+}
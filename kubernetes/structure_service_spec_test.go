@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestServiceLoadBalancerAnnotationWarnings(t *testing.T) {
+	cases := map[string]struct {
+		serviceType v1.ServiceType
+		annotations map[string]string
+		warnings    []string
+	}{
+		"no annotations": {
+			serviceType: v1.ServiceTypeLoadBalancer,
+		},
+		"aws internal and internet-facing": {
+			serviceType: v1.ServiceTypeLoadBalancer,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":   "internet-facing",
+			},
+			warnings: []string{"aws-load-balancer-scheme"},
+		},
+		"aws internal disabled": {
+			serviceType: v1.ServiceTypeLoadBalancer,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "false",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":   "internet-facing",
+			},
+		},
+		"gke internal with backend service": {
+			serviceType: v1.ServiceTypeLoadBalancer,
+			annotations: map[string]string{
+				"networking.gke.io/load-balancer-type": "Internal",
+				"cloud.google.com/l4-rbs":              "enabled",
+			},
+			warnings: []string{"cloud.google.com/l4-rbs"},
+		},
+		"azure internal with public ip and dns label": {
+			serviceType: v1.ServiceTypeLoadBalancer,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/azure-load-balancer-internal": "true",
+				"service.beta.kubernetes.io/azure-pip-name":               "my-ip",
+				"service.beta.kubernetes.io/azure-dns-label-name":         "my-app",
+			},
+			warnings: []string{"azure-pip-name", "azure-dns-label-name"},
+		},
+		"not a load balancer": {
+			serviceType: v1.ServiceTypeClusterIP,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":   "internet-facing",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := serviceLoadBalancerAnnotationWarnings(metav1.ObjectMeta{Annotations: tc.annotations}, v1.ServiceSpec{Type: tc.serviceType})
+			if len(diags) != len(tc.warnings) {
+				t.Fatalf("expected %d warnings, got: %#v", len(tc.warnings), diags)
+			}
+			for i, w := range tc.warnings {
+				if !strings.Contains(diags[i].Detail, w) {
+					t.Fatalf("expected warning %d to mention %q, got: %s", i, w, diags[i].Detail)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceHealthCheckNodePortWarnings(t *testing.T) {
+	cases := map[string]struct {
+		spec    v1.ServiceSpec
+		warning bool
+	}{
+		"local without port": {
+			spec:    v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyLocal},
+			warning: true,
+		},
+		"local with port": {
+			spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyLocal, HealthCheckNodePort: 31000},
+		},
+		"cluster": {
+			spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyCluster},
+		},
+		"node port": {
+			spec: v1.ServiceSpec{Type: v1.ServiceTypeNodePort, ExternalTrafficPolicy: v1.ServiceExternalTrafficPolicyLocal},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := serviceHealthCheckNodePortWarnings(tc.spec)
+			if tc.warning != (len(diags) == 1) {
+				t.Fatalf("expected a warning to be %t, got: %#v", tc.warning, diags)
+			}
+		})
+	}
+}
+
+func TestServiceNodePortDeallocationWarnings(t *testing.T) {
+	ports := []v1.ServicePort{{Port: 80, NodePort: 31080}, {Port: 443, NodePort: 31443}}
+	cases := map[string]struct {
+		spec    v1.ServiceSpec
+		warning bool
+	}{
+		"disabled with node ports": {
+			spec:    v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, AllocateLoadBalancerNodePorts: ptr.To(false), Ports: ports},
+			warning: true,
+		},
+		"disabled without node ports": {
+			spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, AllocateLoadBalancerNodePorts: ptr.To(false), Ports: []v1.ServicePort{{Port: 80}}},
+		},
+		"enabled": {
+			spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, AllocateLoadBalancerNodePorts: ptr.To(true), Ports: ports},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := serviceNodePortDeallocationWarnings(tc.spec)
+			if tc.warning != (len(diags) == 1) {
+				t.Fatalf("expected a warning to be %t, got: %#v", tc.warning, diags)
+			}
+			if tc.warning && !strings.Contains(diags[0].Detail, "31080, 31443") {
+				t.Fatalf("expected the warning to list the node ports, got: %s", diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestFlattenEndpointSliceReadyAddresses(t *testing.T) {
+	slices := []discoveryv1.EndpointSlice{
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+				{Addresses: []string{"10.0.0.1"}},
+			},
+		},
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+			},
+		},
+	}
+
+	expected := []string{"10.0.0.1", "10.0.0.2"}
+	if diff := cmp.Diff(expected, flattenEndpointSliceReadyAddresses(slices)); diff != "" {
+		t.Fatalf("unexpected addresses (-want +got):\n%s", diff)
+	}
+	if addrs := flattenEndpointSliceReadyAddresses(nil); addrs == nil || len(addrs) != 0 {
+		t.Fatalf("expected an empty list without endpoint slices, got: %#v", addrs)
+	}
+}
@@ -328,6 +328,14 @@ func flattenResourceQuotaSpec(in api.ResourceQuotaSpec) []interface{} {
 	return out
 }
 
+func flattenResourceQuotaStatus(in api.ResourceQuotaStatus) []interface{} {
+	m := map[string]interface{}{
+		"hard": flattenResourceList(in.Hard),
+		"used": flattenResourceList(in.Used),
+	}
+	return []interface{}{m}
+}
+
 func expandResourceQuotaSpec(s []interface{}) (*api.ResourceQuotaSpec, error) {
 	out := &api.ResourceQuotaSpec{}
 	if len(s) < 1 {
@@ -630,6 +638,30 @@ func flattenServiceAccountSecrets(in []api.ObjectReference, defaultSecretName st
 	return att
 }
 
+// removeGeneratedServiceAccountTokens removes references to the token secrets
+// that Kubernetes generates for a service account, named
+// "[service account name]-token-[random]", unless they are part of the configuration.
+func removeGeneratedServiceAccountTokens(in []api.ObjectReference, serviceAccountName string, configured []interface{}) []api.ObjectReference {
+	names := make(map[string]bool, len(configured))
+	for _, c := range configured {
+		if p, ok := c.(map[string]interface{}); ok {
+			if name, ok := p["name"].(string); ok {
+				names[name] = true
+			}
+		}
+	}
+
+	prefix := fmt.Sprintf("%s-token-", serviceAccountName)
+	att := make([]api.ObjectReference, 0, len(in))
+	for _, v := range in {
+		if strings.HasPrefix(v.Name, prefix) && !names[v.Name] {
+			continue
+		}
+		att = append(att, v)
+	}
+	return att
+}
+
 func expandServiceAccountSecrets(in []interface{}, defaultSecretName string) []api.ObjectReference {
 	att := make([]api.ObjectReference, 0)
 
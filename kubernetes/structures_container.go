@@ -298,6 +298,9 @@ func flattenContainerVolumeMounts(in []v1.VolumeMount) []interface{} {
 		if v.SubPath != "" {
 			m["sub_path"] = v.SubPath
 		}
+		if v.SubPathExpr != "" {
+			m["sub_path_expr"] = v.SubPathExpr
+		}
 
 		m["mount_propagation"] = string(v1.MountPropagationNone)
 		if v.MountPropagation != nil {
@@ -385,10 +388,30 @@ func flattenContainerPorts(in []v1.ContainerPort) []interface{} {
 	return att
 }
 
+func flattenContainerResizePolicy(in []v1.ContainerResizePolicy) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, p := range in {
+		att[i] = map[string]interface{}{
+			"resource_name":  string(p.ResourceName),
+			"restart_policy": string(p.RestartPolicy),
+		}
+	}
+	return att
+}
+
 func flattenContainerResourceRequirements(in v1.ResourceRequirements) []interface{} {
 	att := make(map[string]interface{})
 	att["limits"] = flattenResourceList(in.Limits)
 	att["requests"] = flattenResourceList(in.Requests)
+	if len(in.Claims) > 0 {
+		claims := make([]interface{}, len(in.Claims))
+		for i, c := range in.Claims {
+			claims[i] = map[string]interface{}{
+				"name": c.Name,
+			}
+		}
+		att["claims"] = claims
+	}
 	return []interface{}{att}
 }
 
@@ -413,6 +436,9 @@ func flattenContainers(in []v1.Container, serviceAccountRegex string) ([]interfa
 		c["tty"] = v.TTY
 		c["working_dir"] = v.WorkingDir
 		c["resources"] = flattenContainerResourceRequirements(v.Resources)
+		if len(v.ResizePolicy) > 0 {
+			c["resize_policy"] = flattenContainerResizePolicy(v.ResizePolicy)
+		}
 		if v.LivenessProbe != nil {
 			c["liveness_probe"] = flattenProbe(v.LivenessProbe)
 		}
@@ -463,11 +489,71 @@ func flattenContainers(in []v1.Container, serviceAccountRegex string) ([]interfa
 	return att, nil
 }
 
+func flattenInitContainers(in []v1.Container, serviceAccountRegex string) ([]interface{}, error) {
+	att, err := flattenContainers(in, serviceAccountRegex)
+	if err != nil {
+		return att, err
+	}
+	for i, v := range in {
+		if v.RestartPolicy != nil {
+			att[i].(map[string]interface{})["restart_policy"] = string(*v.RestartPolicy)
+		}
+	}
+	return att, nil
+}
+
+func flattenEphemeralContainers(in []v1.EphemeralContainer, serviceAccountRegex string) ([]interface{}, error) {
+	containers := make([]v1.Container, len(in))
+	for i, v := range in {
+		containers[i] = v1.Container(v.EphemeralContainerCommon)
+	}
+	att, err := flattenContainers(containers, serviceAccountRegex)
+	if err != nil {
+		return att, err
+	}
+	for i, v := range in {
+		c := att[i].(map[string]interface{})
+		delete(c, "resources")
+		if v.TargetContainerName != "" {
+			c["target_container_name"] = v.TargetContainerName
+		}
+	}
+	return att, nil
+}
+
 // removeVolumeMountFromContainer removes the specified VolumeMount index (i) from the given list of VolumeMounts.
 func removeVolumeMountFromContainer(i int, v []v1.VolumeMount) []v1.VolumeMount {
 	return append(v[:i], v[i+1:]...)
 }
 
+func expandInitContainers(ctrs []interface{}) ([]v1.Container, error) {
+	containers, err := expandContainers(ctrs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range containers {
+		if v, ok := ctrs[i].(map[string]interface{})["restart_policy"].(string); ok && v != "" {
+			containers[i].RestartPolicy = ptr.To(v1.ContainerRestartPolicy(v))
+		}
+	}
+	return containers, nil
+}
+
+func expandEphemeralContainers(ctrs []interface{}) ([]v1.EphemeralContainer, error) {
+	containers, err := expandContainers(ctrs)
+	if err != nil {
+		return nil, err
+	}
+	ecs := make([]v1.EphemeralContainer, len(containers))
+	for i, c := range containers {
+		ecs[i].EphemeralContainerCommon = v1.EphemeralContainerCommon(c)
+		if v, ok := ctrs[i].(map[string]interface{})["target_container_name"].(string); ok {
+			ecs[i].TargetContainerName = v
+		}
+	}
+	return ecs, nil
+}
+
 func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 	if len(ctrs) == 0 {
 		return []v1.Container{}, nil
@@ -505,6 +591,10 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 			cs[i].Resources = *crr
 		}
 
+		if v, ok := ctr["resize_policy"].([]interface{}); ok && len(v) > 0 {
+			cs[i].ResizePolicy = expandContainerResizePolicy(v)
+		}
+
 		if v, ok := ctr["port"].([]interface{}); ok && len(v) > 0 {
 			cp := expandContainerPort(v)
 			for _, p := range cp {
@@ -820,6 +910,9 @@ func expandContainerVolumeMounts(in []interface{}) []v1.VolumeMount {
 		if subPath, ok := p["sub_path"]; ok {
 			vmp[i].SubPath = subPath.(string)
 		}
+		if subPathExpr, ok := p["sub_path_expr"]; ok {
+			vmp[i].SubPathExpr = subPathExpr.(string)
+		}
 		if mountPropagation, ok := p["mount_propagation"]; ok {
 			mp := v1.MountPropagationMode(mountPropagation.(string))
 			vmp[i].MountPropagation = &mp
@@ -1059,6 +1152,21 @@ func expandConfigMapRef(r []interface{}) *v1.ConfigMapEnvSource {
 	return obj
 }
 
+func expandContainerResizePolicy(l []interface{}) []v1.ContainerResizePolicy {
+	policies := make([]v1.ContainerResizePolicy, 0, len(l))
+	for _, p := range l {
+		if p == nil {
+			continue
+		}
+		in := p.(map[string]interface{})
+		policies = append(policies, v1.ContainerResizePolicy{
+			ResourceName:  v1.ResourceName(in["resource_name"].(string)),
+			RestartPolicy: v1.ResourceResizeRestartPolicy(in["restart_policy"].(string)),
+		})
+	}
+	return policies
+}
+
 func expandContainerResourceRequirements(l []interface{}) (*v1.ResourceRequirements, error) {
 	obj := &v1.ResourceRequirements{}
 	if len(l) == 0 || l[0] == nil {
@@ -1082,5 +1190,13 @@ func expandContainerResourceRequirements(l []interface{}) (*v1.ResourceRequireme
 		obj.Requests = *r
 	}
 
+	if v, ok := in["claims"].([]interface{}); ok {
+		for _, c := range v {
+			if m, ok := c.(map[string]interface{}); ok {
+				obj.Claims = append(obj.Claims, v1.ResourceClaim{Name: m["name"].(string)})
+			}
+		}
+	}
+
 	return obj, nil
 }
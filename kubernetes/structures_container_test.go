@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 )
@@ -312,3 +313,128 @@ func TestFlattenContainerVolumeMounts_mountPropogation(t *testing.T) {
 		}
 	}
 }
+
+func TestEphemeralContainersRoundTrip(t *testing.T) {
+	in := []v1.EphemeralContainer{
+		{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:                     "debugger",
+				Image:                    "busybox:1.36",
+				Command:                  []string{"sh"},
+				Args:                     []string{"-c", "sleep 3600"},
+				ImagePullPolicy:          v1.PullIfNotPresent,
+				TerminationMessagePath:   "/dev/termination-log",
+				TerminationMessagePolicy: v1.TerminationMessageReadFile,
+				Stdin:                    true,
+				TTY:                      true,
+			},
+			TargetContainerName: "app",
+		},
+	}
+
+	flattened, err := flattenEphemeralContainers(in, "default-token-([a-z0-9]{5})")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPodSchemaV1(), map[string]interface{}{})
+	if err := d.Set("spec", []interface{}{map[string]interface{}{"ephemeral_container": flattened}}); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandEphemeralContainers(d.Get("spec.0.ephemeral_container").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Unexpected output from round trip.\nExpected: %#v\nGiven:    %#v", in, out)
+	}
+}
+
+func TestInitContainersRoundTrip(t *testing.T) {
+	in := []v1.Container{
+		{
+			Name:                     "sidecar",
+			Image:                    "busybox:1.36",
+			Command:                  []string{"sh"},
+			Args:                     []string{"-c", "sleep 3600"},
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			TerminationMessagePath:   "/dev/termination-log",
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			RestartPolicy:            ptr.To(v1.ContainerRestartPolicyAlways),
+		},
+	}
+
+	flattened, err := flattenInitContainers(in, "default-token-([a-z0-9]{5})")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPodSchemaV1(), map[string]interface{}{})
+	if err := d.Set("spec", []interface{}{map[string]interface{}{"init_container": flattened}}); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandInitContainers(d.Get("spec.0.init_container").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Unexpected output from round trip.\nExpected: %#v\nGiven:    %#v", in, out)
+	}
+}
+
+func TestContainerResizePolicyRoundTrip(t *testing.T) {
+	in := []v1.ContainerResizePolicy{
+		{ResourceName: v1.ResourceCPU, RestartPolicy: v1.NotRequired},
+		{ResourceName: v1.ResourceMemory, RestartPolicy: v1.RestartContainer},
+	}
+	out := expandContainerResizePolicy(flattenContainerResizePolicy(in))
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Unexpected output from round trip.\nExpected: %#v\nGiven:    %#v", in, out)
+	}
+}
+
+func TestContainerProbesGRPCRoundTrip(t *testing.T) {
+	probe := func(service *string) *v1.Probe {
+		return &v1.Probe{
+			ProbeHandler: v1.ProbeHandler{
+				GRPC: &v1.GRPCAction{
+					Port:    8888,
+					Service: service,
+				},
+			},
+			InitialDelaySeconds: 5,
+			TimeoutSeconds:      1,
+			PeriodSeconds:       10,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		}
+	}
+	in := []v1.Container{
+		{
+			Name:                     "server",
+			Image:                    "registry.k8s.io/e2e-test-images/agnhost:2.40",
+			Command:                  []string{"/agnhost"},
+			Args:                     []string{"grpc-health-checking"},
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			TerminationMessagePath:   "/dev/termination-log",
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			LivenessProbe:            probe(ptr.To("EchoService")),
+			ReadinessProbe:           probe(ptr.To("")),
+			StartupProbe:             probe(ptr.To("EchoService")),
+		},
+	}
+
+	flattened, err := flattenContainers(in, "default-token-([a-z0-9]{5})")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPodSchemaV1(), map[string]interface{}{})
+	if err := d.Set("spec", []interface{}{map[string]interface{}{"container": flattened}}); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandContainers(d.Get("spec.0.container").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Unexpected output from round trip.\nExpected: %#v\nGiven:    %#v", in, out)
+	}
+}
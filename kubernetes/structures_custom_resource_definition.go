@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Flatteners
+
+func flattenCustomResourceDefinitionV1Spec(in apiextensionsv1.CustomResourceDefinitionSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["group"] = in.Group
+	att["names"] = flattenCustomResourceDefinitionV1Names(in.Names)
+	att["scope"] = string(in.Scope)
+
+	versions := make([]interface{}, len(in.Versions))
+	for i, v := range in.Versions {
+		version, err := flattenCustomResourceDefinitionV1Version(v)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = version
+	}
+	att["version"] = versions
+
+	if in.Conversion != nil {
+		att["conversion"] = flattenCustomResourceDefinitionV1Conversion(*in.Conversion)
+	}
+	att["preserve_unknown_fields"] = in.PreserveUnknownFields
+
+	return []interface{}{att}, nil
+}
+
+func flattenCustomResourceDefinitionV1Names(in apiextensionsv1.CustomResourceDefinitionNames) []interface{} {
+	att := map[string]interface{}{
+		"kind":      in.Kind,
+		"plural":    in.Plural,
+		"singular":  in.Singular,
+		"list_kind": in.ListKind,
+	}
+	if len(in.ShortNames) > 0 {
+		att["short_names"] = in.ShortNames
+	}
+	if len(in.Categories) > 0 {
+		att["categories"] = in.Categories
+	}
+	return []interface{}{att}
+}
+
+func flattenCustomResourceDefinitionV1Version(in apiextensionsv1.CustomResourceDefinitionVersion) (map[string]interface{}, error) {
+	att := map[string]interface{}{
+		"name":       in.Name,
+		"served":     in.Served,
+		"storage":    in.Storage,
+		"deprecated": in.Deprecated,
+	}
+	if in.DeprecationWarning != nil {
+		att["deprecation_warning"] = *in.DeprecationWarning
+	}
+
+	if in.Schema != nil && in.Schema.OpenAPIV3Schema != nil {
+		s, err := json.Marshal(in.Schema.OpenAPIV3Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode OpenAPI v3 schema of version %q: %s", in.Name, err)
+		}
+		att["schema"] = []interface{}{map[string]interface{}{
+			"open_api_v3_schema": string(s),
+		}}
+	}
+
+	if in.Subresources != nil {
+		sr := map[string]interface{}{
+			"status": in.Subresources.Status != nil,
+		}
+		if in.Subresources.Scale != nil {
+			scale := map[string]interface{}{
+				"spec_replicas_path":   in.Subresources.Scale.SpecReplicasPath,
+				"status_replicas_path": in.Subresources.Scale.StatusReplicasPath,
+			}
+			if in.Subresources.Scale.LabelSelectorPath != nil {
+				scale["label_selector_path"] = *in.Subresources.Scale.LabelSelectorPath
+			}
+			sr["scale"] = []interface{}{scale}
+		}
+		att["subresources"] = []interface{}{sr}
+	}
+
+	if len(in.AdditionalPrinterColumns) > 0 {
+		columns := make([]interface{}, len(in.AdditionalPrinterColumns))
+		for i, c := range in.AdditionalPrinterColumns {
+			columns[i] = map[string]interface{}{
+				"name":        c.Name,
+				"type":        c.Type,
+				"json_path":   c.JSONPath,
+				"description": c.Description,
+				"format":      c.Format,
+				"priority":    int(c.Priority),
+			}
+		}
+		att["additional_printer_column"] = columns
+	}
+
+	return att, nil
+}
+
+func flattenCustomResourceDefinitionV1Conversion(in apiextensionsv1.CustomResourceConversion) []interface{} {
+	att := map[string]interface{}{
+		"strategy": string(in.Strategy),
+	}
+	if in.Webhook != nil {
+		wh := map[string]interface{}{
+			"conversion_review_versions": in.Webhook.ConversionReviewVersions,
+		}
+		if in.Webhook.ClientConfig != nil {
+			wh["client_config"] = flattenCustomResourceDefinitionV1WebhookClientConfig(*in.Webhook.ClientConfig)
+		}
+		att["webhook"] = []interface{}{wh}
+	}
+	return []interface{}{att}
+}
+
+func flattenCustomResourceDefinitionV1WebhookClientConfig(in apiextensionsv1.WebhookClientConfig) []interface{} {
+	att := map[string]interface{}{}
+
+	if len(in.CABundle) > 0 {
+		att["ca_bundle"] = string(in.CABundle)
+	}
+
+	if in.Service != nil {
+		svc := map[string]interface{}{
+			"name":      in.Service.Name,
+			"namespace": in.Service.Namespace,
+		}
+		if in.Service.Path != nil {
+			svc["path"] = *in.Service.Path
+		}
+		if in.Service.Port != nil {
+			svc["port"] = *in.Service.Port
+		}
+		att["service"] = []interface{}{svc}
+	}
+
+	if in.URL != nil {
+		att["url"] = *in.URL
+	}
+
+	return []interface{}{att}
+}
+
+func flattenCustomResourceDefinitionV1Status(in apiextensionsv1.CustomResourceDefinitionStatus) []interface{} {
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, condition := range in.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":                 string(condition.Type),
+			"status":               string(condition.Status),
+			"last_transition_time": condition.LastTransitionTime.String(),
+			"reason":               condition.Reason,
+			"message":              condition.Message,
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"conditions":      conditions,
+		"stored_versions": in.StoredVersions,
+	}}
+}
+
+// Expanders
+
+func expandCustomResourceDefinitionV1Spec(l []interface{}) (apiextensionsv1.CustomResourceDefinitionSpec, error) {
+	obj := apiextensionsv1.CustomResourceDefinitionSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["group"].(string); ok {
+		obj.Group = v
+	}
+	if v, ok := in["names"].([]interface{}); ok {
+		obj.Names = expandCustomResourceDefinitionV1Names(v)
+	}
+	if v, ok := in["scope"].(string); ok {
+		obj.Scope = apiextensionsv1.ResourceScope(v)
+	}
+	if v, ok := in["version"].([]interface{}); ok {
+		for _, vv := range v {
+			version, err := expandCustomResourceDefinitionV1Version(vv.(map[string]interface{}))
+			if err != nil {
+				return obj, err
+			}
+			obj.Versions = append(obj.Versions, version)
+		}
+	}
+	if v, ok := in["conversion"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.Conversion = expandCustomResourceDefinitionV1Conversion(v[0].(map[string]interface{}))
+	}
+	if v, ok := in["preserve_unknown_fields"].(bool); ok {
+		obj.PreserveUnknownFields = v
+	}
+
+	return obj, nil
+}
+
+func expandCustomResourceDefinitionV1Names(l []interface{}) apiextensionsv1.CustomResourceDefinitionNames {
+	obj := apiextensionsv1.CustomResourceDefinitionNames{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Kind = in["kind"].(string)
+	obj.Plural = in["plural"].(string)
+	if v, ok := in["singular"].(string); ok {
+		obj.Singular = v
+	}
+	if v, ok := in["list_kind"].(string); ok {
+		obj.ListKind = v
+	}
+	if v, ok := in["short_names"].([]interface{}); ok && len(v) > 0 {
+		obj.ShortNames = expandStringSlice(v)
+	}
+	if v, ok := in["categories"].([]interface{}); ok && len(v) > 0 {
+		obj.Categories = expandStringSlice(v)
+	}
+
+	return obj
+}
+
+func expandCustomResourceDefinitionV1Version(in map[string]interface{}) (apiextensionsv1.CustomResourceDefinitionVersion, error) {
+	obj := apiextensionsv1.CustomResourceDefinitionVersion{
+		Name:    in["name"].(string),
+		Served:  in["served"].(bool),
+		Storage: in["storage"].(bool),
+	}
+	if v, ok := in["deprecated"].(bool); ok {
+		obj.Deprecated = v
+	}
+	if v, ok := in["deprecation_warning"].(string); ok && v != "" {
+		obj.DeprecationWarning = ptr.To(v)
+	}
+
+	if v, ok := in["schema"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s := v[0].(map[string]interface{})["open_api_v3_schema"].(string)
+		props := &apiextensionsv1.JSONSchemaProps{}
+		if err := json.Unmarshal([]byte(s), props); err != nil {
+			return obj, fmt.Errorf("failed to decode OpenAPI v3 schema of version %q: %s", obj.Name, err)
+		}
+		obj.Schema = &apiextensionsv1.CustomResourceValidation{OpenAPIV3Schema: props}
+	}
+
+	if v, ok := in["subresources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sr := v[0].(map[string]interface{})
+		obj.Subresources = &apiextensionsv1.CustomResourceSubresources{}
+		if sr["status"].(bool) {
+			obj.Subresources.Status = &apiextensionsv1.CustomResourceSubresourceStatus{}
+		}
+		if scale, ok := sr["scale"].([]interface{}); ok && len(scale) > 0 && scale[0] != nil {
+			sc := scale[0].(map[string]interface{})
+			obj.Subresources.Scale = &apiextensionsv1.CustomResourceSubresourceScale{
+				SpecReplicasPath:   sc["spec_replicas_path"].(string),
+				StatusReplicasPath: sc["status_replicas_path"].(string),
+			}
+			if p, ok := sc["label_selector_path"].(string); ok && p != "" {
+				obj.Subresources.Scale.LabelSelectorPath = ptr.To(p)
+			}
+		}
+	}
+
+	if v, ok := in["additional_printer_column"].([]interface{}); ok {
+		for _, c := range v {
+			col := c.(map[string]interface{})
+			obj.AdditionalPrinterColumns = append(obj.AdditionalPrinterColumns, apiextensionsv1.CustomResourceColumnDefinition{
+				Name:        col["name"].(string),
+				Type:        col["type"].(string),
+				JSONPath:    col["json_path"].(string),
+				Description: col["description"].(string),
+				Format:      col["format"].(string),
+				Priority:    int32(col["priority"].(int)),
+			})
+		}
+	}
+
+	return obj, nil
+}
+
+func expandCustomResourceDefinitionV1Conversion(in map[string]interface{}) *apiextensionsv1.CustomResourceConversion {
+	obj := &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.ConversionStrategyType(in["strategy"].(string)),
+	}
+	if v, ok := in["webhook"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		wh := v[0].(map[string]interface{})
+		obj.Webhook = &apiextensionsv1.WebhookConversion{
+			ClientConfig: expandCustomResourceDefinitionV1WebhookClientConfig(wh["client_config"].([]interface{})),
+		}
+		if crv, ok := wh["conversion_review_versions"].([]interface{}); ok && len(crv) > 0 {
+			obj.Webhook.ConversionReviewVersions = expandStringSlice(crv)
+		} else {
+			obj.Webhook.ConversionReviewVersions = []string{"v1"}
+		}
+	}
+	return obj
+}
+
+func expandCustomResourceDefinitionV1WebhookClientConfig(l []interface{}) *apiextensionsv1.WebhookClientConfig {
+	obj := &apiextensionsv1.WebhookClientConfig{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["ca_bundle"].(string); ok && v != "" {
+		obj.CABundle = []byte(v)
+	}
+	if v, ok := in["service"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		svc := v[0].(map[string]interface{})
+		obj.Service = &apiextensionsv1.ServiceReference{
+			Name:      svc["name"].(string),
+			Namespace: svc["namespace"].(string),
+		}
+		if p, ok := svc["path"].(string); ok && p != "" {
+			obj.Service.Path = ptr.To(p)
+		}
+		if p, ok := svc["port"].(int); ok {
+			obj.Service.Port = ptr.To(int32(p))
+		}
+	}
+	if v, ok := in["url"].(string); ok && v != "" {
+		obj.URL = ptr.To(v)
+	}
+
+	return obj
+}
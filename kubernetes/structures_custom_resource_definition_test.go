@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCustomResourceDefinitionV1SpecRoundTrip(t *testing.T) {
+	spec := apiextensionsv1.CustomResourceDefinitionSpec{
+		Group: "stable.example.com",
+		Names: apiextensionsv1.CustomResourceDefinitionNames{
+			Kind:       "CronTab",
+			Plural:     "crontabs",
+			Singular:   "crontab",
+			ListKind:   "CronTabList",
+			ShortNames: []string{"ct"},
+		},
+		Scope: apiextensionsv1.NamespaceScoped,
+		Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+			{
+				Name:    "v1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"spec": {
+								Type:     "object",
+								Required: []string{"cronSpec"},
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"cronSpec": {Type: "string"},
+									"replicas": {Type: "integer", Minimum: ptr.To(float64(1))},
+								},
+							},
+						},
+					},
+				},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+					Scale: &apiextensionsv1.CustomResourceSubresourceScale{
+						SpecReplicasPath:   ".spec.replicas",
+						StatusReplicasPath: ".status.replicas",
+					},
+				},
+				AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+					{Name: "Spec", Type: "string", JSONPath: ".spec.cronSpec"},
+				},
+			},
+		},
+		Conversion: &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					CABundle: []byte(testAccClusterTrustBundleCertificate),
+					Service: &apiextensionsv1.ServiceReference{
+						Name:      "converter",
+						Namespace: "default",
+						Path:      ptr.To("/convert"),
+						Port:      ptr.To(int32(443)),
+					},
+				},
+				ConversionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+
+	flattened, err := flattenCustomResourceDefinitionV1Spec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesCustomResourceDefinitionV1().Schema, map[string]interface{}{})
+	if err := d.Set("spec", flattened); err != nil {
+		t.Fatal(err)
+	}
+	expanded, err := expandCustomResourceDefinitionV1Spec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(spec, expanded); diff != "" {
+		t.Fatalf("spec does not survive a round trip (-want +got):\n%s", diff)
+	}
+}
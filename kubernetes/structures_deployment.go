@@ -16,6 +16,7 @@ import (
 func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 	att["min_ready_seconds"] = in.MinReadySeconds
+	att["paused"] = in.Paused
 
 	if in.Replicas != nil {
 		att["replicas"] = strconv.Itoa(int(*in.Replicas))
@@ -47,6 +48,30 @@ func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData, met
 	return []interface{}{att}, nil
 }
 
+func flattenDeploymentV1Status(in appsv1.DeploymentStatus) []interface{} {
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":                 string(c.Type),
+			"status":               string(c.Status),
+			"reason":               c.Reason,
+			"message":              c.Message,
+			"last_update_time":     c.LastUpdateTime.String(),
+			"last_transition_time": c.LastTransitionTime.String(),
+		}
+	}
+	att := map[string]interface{}{
+		"observed_generation":  int(in.ObservedGeneration),
+		"replicas":             int(in.Replicas),
+		"updated_replicas":     int(in.UpdatedReplicas),
+		"ready_replicas":       int(in.ReadyReplicas),
+		"available_replicas":   int(in.AvailableReplicas),
+		"unavailable_replicas": int(in.UnavailableReplicas),
+		"conditions":           conditions,
+	}
+	return []interface{}{att}
+}
+
 func flattenDeploymentStrategy(in appsv1.DeploymentStrategy) []interface{} {
 	att := make(map[string]interface{})
 	if in.Type != "" {
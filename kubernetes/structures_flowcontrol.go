@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/utils/ptr"
+)
+
+// Flow Schema
+
+func expandFlowSchemaV1Beta3Spec(l []interface{}) flowcontrolv1beta3.FlowSchemaSpec {
+	obj := flowcontrolv1beta3.FlowSchemaSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["priority_level_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		plc := v[0].(map[string]interface{})
+		obj.PriorityLevelConfiguration.Name = plc["name"].(string)
+	}
+	if v, ok := in["matching_precedence"].(int); ok && v > 0 {
+		obj.MatchingPrecedence = int32(v)
+	}
+	if v, ok := in["distinguisher_method"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dm := v[0].(map[string]interface{})
+		obj.DistinguisherMethod = &flowcontrolv1beta3.FlowDistinguisherMethod{
+			Type: flowcontrolv1beta3.FlowDistinguisherMethodType(dm["type"].(string)),
+		}
+	}
+	if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
+		obj.Rules = expandFlowSchemaV1Beta3Rules(v)
+	}
+
+	return obj
+}
+
+func expandFlowSchemaV1Beta3Rules(l []interface{}) []flowcontrolv1beta3.PolicyRulesWithSubjects {
+	obj := make([]flowcontrolv1beta3.PolicyRulesWithSubjects, 0, len(l))
+	for _, r := range l {
+		if r == nil {
+			continue
+		}
+		in := r.(map[string]interface{})
+		rule := flowcontrolv1beta3.PolicyRulesWithSubjects{}
+
+		if v, ok := in["subject"].([]interface{}); ok {
+			rule.Subjects = expandFlowSchemaV1Beta3Subjects(v)
+		}
+		if v, ok := in["resource_rule"].([]interface{}); ok {
+			for _, rr := range v {
+				if rr == nil {
+					continue
+				}
+				m := rr.(map[string]interface{})
+				rule.ResourceRules = append(rule.ResourceRules, flowcontrolv1beta3.ResourcePolicyRule{
+					Verbs:        expandStringSlice(m["verbs"].([]interface{})),
+					APIGroups:    expandStringSlice(m["api_groups"].([]interface{})),
+					Resources:    expandStringSlice(m["resources"].([]interface{})),
+					ClusterScope: m["cluster_scope"].(bool),
+					Namespaces:   expandStringSlice(m["namespaces"].([]interface{})),
+				})
+			}
+		}
+		if v, ok := in["non_resource_rule"].([]interface{}); ok {
+			for _, nr := range v {
+				if nr == nil {
+					continue
+				}
+				m := nr.(map[string]interface{})
+				rule.NonResourceRules = append(rule.NonResourceRules, flowcontrolv1beta3.NonResourcePolicyRule{
+					Verbs:           expandStringSlice(m["verbs"].([]interface{})),
+					NonResourceURLs: expandStringSlice(m["non_resource_urls"].([]interface{})),
+				})
+			}
+		}
+
+		obj = append(obj, rule)
+	}
+	return obj
+}
+
+func expandFlowSchemaV1Beta3Subjects(l []interface{}) []flowcontrolv1beta3.Subject {
+	obj := make([]flowcontrolv1beta3.Subject, 0, len(l))
+	for _, s := range l {
+		if s == nil {
+			continue
+		}
+		in := s.(map[string]interface{})
+		subject := flowcontrolv1beta3.Subject{
+			Kind: flowcontrolv1beta3.SubjectKind(in["kind"].(string)),
+		}
+		if v, ok := in["user"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			subject.User = &flowcontrolv1beta3.UserSubject{
+				Name: m["name"].(string),
+			}
+		}
+		if v, ok := in["group"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			subject.Group = &flowcontrolv1beta3.GroupSubject{
+				Name: m["name"].(string),
+			}
+		}
+		if v, ok := in["service_account"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			subject.ServiceAccount = &flowcontrolv1beta3.ServiceAccountSubject{
+				Namespace: m["namespace"].(string),
+				Name:      m["name"].(string),
+			}
+		}
+		obj = append(obj, subject)
+	}
+	return obj
+}
+
+func flattenFlowSchemaV1Beta3Spec(in flowcontrolv1beta3.FlowSchemaSpec) []interface{} {
+	att := map[string]interface{}{
+		"priority_level_configuration": []interface{}{
+			map[string]interface{}{
+				"name": in.PriorityLevelConfiguration.Name,
+			},
+		},
+		"matching_precedence": int(in.MatchingPrecedence),
+	}
+	if in.DistinguisherMethod != nil {
+		att["distinguisher_method"] = []interface{}{
+			map[string]interface{}{
+				"type": string(in.DistinguisherMethod.Type),
+			},
+		}
+	}
+	if len(in.Rules) > 0 {
+		att["rule"] = flattenFlowSchemaV1Beta3Rules(in.Rules)
+	}
+	return []interface{}{att}
+}
+
+func flattenFlowSchemaV1Beta3Rules(in []flowcontrolv1beta3.PolicyRulesWithSubjects) []interface{} {
+	att := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"subject": flattenFlowSchemaV1Beta3Subjects(r.Subjects),
+		}
+
+		resourceRules := make([]interface{}, 0, len(r.ResourceRules))
+		for _, rr := range r.ResourceRules {
+			resourceRules = append(resourceRules, map[string]interface{}{
+				"verbs":         rr.Verbs,
+				"api_groups":    rr.APIGroups,
+				"resources":     rr.Resources,
+				"cluster_scope": rr.ClusterScope,
+				"namespaces":    rr.Namespaces,
+			})
+		}
+		rule["resource_rule"] = resourceRules
+
+		nonResourceRules := make([]interface{}, 0, len(r.NonResourceRules))
+		for _, nr := range r.NonResourceRules {
+			nonResourceRules = append(nonResourceRules, map[string]interface{}{
+				"verbs":             nr.Verbs,
+				"non_resource_urls": nr.NonResourceURLs,
+			})
+		}
+		rule["non_resource_rule"] = nonResourceRules
+
+		att = append(att, rule)
+	}
+	return att
+}
+
+func flattenFlowSchemaV1Beta3Subjects(in []flowcontrolv1beta3.Subject) []interface{} {
+	att := make([]interface{}, 0, len(in))
+	for _, s := range in {
+		subject := map[string]interface{}{
+			"kind": string(s.Kind),
+		}
+		if s.User != nil {
+			subject["user"] = []interface{}{
+				map[string]interface{}{"name": s.User.Name},
+			}
+		}
+		if s.Group != nil {
+			subject["group"] = []interface{}{
+				map[string]interface{}{"name": s.Group.Name},
+			}
+		}
+		if s.ServiceAccount != nil {
+			subject["service_account"] = []interface{}{
+				map[string]interface{}{
+					"namespace": s.ServiceAccount.Namespace,
+					"name":      s.ServiceAccount.Name,
+				},
+			}
+		}
+		att = append(att, subject)
+	}
+	return att
+}
+
+// Priority Level Configuration
+
+func expandPriorityLevelConfigurationV1Beta3Spec(l []interface{}) flowcontrolv1beta3.PriorityLevelConfigurationSpec {
+	obj := flowcontrolv1beta3.PriorityLevelConfigurationSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Type = flowcontrolv1beta3.PriorityLevelEnablement(in["type"].(string))
+
+	if v, ok := in["limited"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.Limited = expandPriorityLevelConfigurationV1Beta3Limited(v[0].(map[string]interface{}))
+	}
+	if v, ok := in["exempt"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		exempt := &flowcontrolv1beta3.ExemptPriorityLevelConfiguration{}
+		if s, ok := m["nominal_concurrency_shares"].(int); ok && s > 0 {
+			exempt.NominalConcurrencyShares = ptr.To(int32(s))
+		}
+		if p, ok := m["lendable_percent"].(int); ok && p > 0 {
+			exempt.LendablePercent = ptr.To(int32(p))
+		}
+		obj.Exempt = exempt
+	}
+
+	return obj
+}
+
+func expandPriorityLevelConfigurationV1Beta3Limited(in map[string]interface{}) *flowcontrolv1beta3.LimitedPriorityLevelConfiguration {
+	obj := &flowcontrolv1beta3.LimitedPriorityLevelConfiguration{}
+
+	if v, ok := in["nominal_concurrency_shares"].(int); ok && v > 0 {
+		obj.NominalConcurrencyShares = int32(v)
+	}
+	if v, ok := in["lendable_percent"].(int); ok && v > 0 {
+		obj.LendablePercent = ptr.To(int32(v))
+	}
+	if v, ok := in["borrowing_limit_percent"].(int); ok && v > 0 {
+		obj.BorrowingLimitPercent = ptr.To(int32(v))
+	}
+	if v, ok := in["limit_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		lr := v[0].(map[string]interface{})
+		obj.LimitResponse.Type = flowcontrolv1beta3.LimitResponseType(lr["type"].(string))
+		if q, ok := lr["queuing"].([]interface{}); ok && len(q) > 0 && q[0] != nil &&
+			obj.LimitResponse.Type == flowcontrolv1beta3.LimitResponseTypeQueue {
+			qm := q[0].(map[string]interface{})
+			queuing := &flowcontrolv1beta3.QueuingConfiguration{}
+			if v, ok := qm["queues"].(int); ok && v > 0 {
+				queuing.Queues = int32(v)
+			}
+			if v, ok := qm["hand_size"].(int); ok && v > 0 {
+				queuing.HandSize = int32(v)
+			}
+			if v, ok := qm["queue_length_limit"].(int); ok && v > 0 {
+				queuing.QueueLengthLimit = int32(v)
+			}
+			obj.LimitResponse.Queuing = queuing
+		}
+	}
+
+	return obj
+}
+
+func flattenPriorityLevelConfigurationV1Beta3Spec(in flowcontrolv1beta3.PriorityLevelConfigurationSpec) []interface{} {
+	att := map[string]interface{}{
+		"type": string(in.Type),
+	}
+	if in.Limited != nil {
+		att["limited"] = flattenPriorityLevelConfigurationV1Beta3Limited(in.Limited)
+	}
+	if in.Exempt != nil {
+		exempt := map[string]interface{}{}
+		if in.Exempt.NominalConcurrencyShares != nil {
+			exempt["nominal_concurrency_shares"] = int(*in.Exempt.NominalConcurrencyShares)
+		}
+		if in.Exempt.LendablePercent != nil {
+			exempt["lendable_percent"] = int(*in.Exempt.LendablePercent)
+		}
+		att["exempt"] = []interface{}{exempt}
+	}
+	return []interface{}{att}
+}
+
+func flattenPriorityLevelConfigurationV1Beta3Limited(in *flowcontrolv1beta3.LimitedPriorityLevelConfiguration) []interface{} {
+	att := map[string]interface{}{
+		"nominal_concurrency_shares": int(in.NominalConcurrencyShares),
+	}
+	if in.LendablePercent != nil {
+		att["lendable_percent"] = int(*in.LendablePercent)
+	}
+	if in.BorrowingLimitPercent != nil {
+		att["borrowing_limit_percent"] = int(*in.BorrowingLimitPercent)
+	}
+
+	lr := map[string]interface{}{
+		"type": string(in.LimitResponse.Type),
+	}
+	if q := in.LimitResponse.Queuing; q != nil {
+		lr["queuing"] = []interface{}{
+			map[string]interface{}{
+				"queues":             int(q.Queues),
+				"hand_size":          int(q.HandSize),
+				"queue_length_limit": int(q.QueueLengthLimit),
+			},
+		}
+	}
+	att["limit_response"] = []interface{}{lr}
+
+	return []interface{}{att}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func expandLeaseV1Spec(l []interface{}) (coordinationv1.LeaseSpec, error) {
+	obj := coordinationv1.LeaseSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["holder_identity"].(string); ok && v != "" {
+		obj.HolderIdentity = ptr.To(v)
+	}
+	if v, ok := in["lease_duration_seconds"].(int); ok && v > 0 {
+		obj.LeaseDurationSeconds = ptr.To(int32(v))
+	}
+	if v, ok := in["acquire_time"].(string); ok && v != "" {
+		t, err := expandLeaseV1MicroTime(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.AcquireTime = t
+	}
+	if v, ok := in["renew_time"].(string); ok && v != "" {
+		t, err := expandLeaseV1MicroTime(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.RenewTime = t
+	}
+	if v, ok := in["lease_transitions"].(int); ok && v > 0 {
+		obj.LeaseTransitions = ptr.To(int32(v))
+	}
+
+	return obj, nil
+}
+
+func expandLeaseV1MicroTime(v string) (*metav1.MicroTime, error) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse time %q: %s", v, err)
+	}
+	mt := metav1.NewMicroTime(t)
+	return &mt, nil
+}
+
+func flattenLeaseV1Spec(in coordinationv1.LeaseSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.HolderIdentity != nil {
+		att["holder_identity"] = *in.HolderIdentity
+	}
+	if in.LeaseDurationSeconds != nil {
+		att["lease_duration_seconds"] = int(*in.LeaseDurationSeconds)
+	}
+	if in.AcquireTime != nil {
+		att["acquire_time"] = in.AcquireTime.UTC().Format(metav1.RFC3339Micro)
+	}
+	if in.RenewTime != nil {
+		att["renew_time"] = in.RenewTime.UTC().Format(metav1.RFC3339Micro)
+	}
+	if in.LeaseTransitions != nil {
+		att["lease_transitions"] = int(*in.LeaseTransitions)
+	}
+
+	return []interface{}{att}
+}
@@ -4,16 +4,19 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 )
 
@@ -30,6 +33,39 @@ var builtInTolerations = map[string]string{
 
 // Flatteners
 
+func flattenPodConditions(in []v1.PodCondition) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		att[i] = map[string]interface{}{
+			"type":    string(c.Type),
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+	}
+	return att
+}
+
+func flattenContainerStatuses(in []v1.ContainerStatus) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		m := map[string]interface{}{
+			"name":          c.Name,
+			"ready":         c.Ready,
+			"started":       false,
+			"restart_count": int(c.RestartCount),
+			"image":         c.Image,
+			"image_id":      c.ImageID,
+			"container_id":  c.ContainerID,
+		}
+		if c.Started != nil {
+			m["started"] = *c.Started
+		}
+		att[i] = m
+	}
+	return att
+}
+
 func flattenOS(in v1.PodOS) []interface{} {
 	att := make(map[string]interface{})
 	if in.Name != "" {
@@ -67,7 +103,15 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 
 	att["readiness_gate"] = flattenReadinessGates(in.ReadinessGates)
 
-	initContainers, err := flattenContainers(in.InitContainers, serviceAccountRegex)
+	if len(in.SchedulingGates) > 0 {
+		att["scheduling_gate"] = flattenSchedulingGates(in.SchedulingGates)
+	}
+
+	if len(in.ResourceClaims) > 0 {
+		att["resource_claim"] = flattenPodResourceClaims(in.ResourceClaims)
+	}
+
+	initContainers, err := flattenInitContainers(in.InitContainers, serviceAccountRegex)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +154,14 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.RuntimeClassName != nil {
 		att["runtime_class_name"] = *in.RuntimeClassName
 	}
+
+	if len(in.Overhead) > 0 {
+		att["overhead"] = []interface{}{
+			map[string]interface{}{
+				"pod_fixed": flattenResourceList(in.Overhead),
+			},
+		}
+	}
 	if in.PriorityClassName != "" {
 		att["priority_class_name"] = in.PriorityClassName
 	}
@@ -674,6 +726,34 @@ func flattenReadinessGates(in []v1.PodReadinessGate) []interface{} {
 	return att
 }
 
+func flattenSchedulingGates(in []v1.PodSchedulingGate) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"name": v.Name,
+		}
+	}
+	return att
+}
+
+func flattenPodResourceClaims(in []v1.PodResourceClaim) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		source := make(map[string]interface{})
+		if v.Source.ResourceClaimName != nil {
+			source["resource_claim_name"] = *v.Source.ResourceClaimName
+		}
+		if v.Source.ResourceClaimTemplateName != nil {
+			source["resource_claim_template_name"] = *v.Source.ResourceClaimTemplateName
+		}
+		att[i] = map[string]interface{}{
+			"name":   v.Name,
+			"source": []interface{}{source},
+		}
+	}
+	return att
+}
+
 func flattenPersistentVolumeClaimMetadata(in metav1.ObjectMeta) map[string]interface{} {
 	att := make(map[string]interface{})
 
@@ -755,8 +835,16 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.ReadinessGates = expandReadinessGates(v)
 	}
 
+	if v, ok := in["scheduling_gate"].([]interface{}); ok && len(v) > 0 {
+		obj.SchedulingGates = expandSchedulingGates(v)
+	}
+
+	if v, ok := in["resource_claim"].([]interface{}); ok && len(v) > 0 {
+		obj.ResourceClaims = expandPodResourceClaims(v)
+	}
+
 	if v, ok := in["init_container"].([]interface{}); ok && len(v) > 0 {
-		cs, err := expandContainers(v)
+		cs, err := expandInitContainers(v)
 		if err != nil {
 			return obj, err
 		}
@@ -826,6 +914,16 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.RuntimeClassName = ptr.To(v)
 	}
 
+	if v, ok := in["overhead"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if pf, ok := v[0].(map[string]interface{})["pod_fixed"].(map[string]interface{}); ok && len(pf) > 0 {
+			rl, err := expandMapToResourceList(pf)
+			if err != nil {
+				return obj, err
+			}
+			obj.Overhead = *rl
+		}
+	}
+
 	if v, ok := in["priority_class_name"].(string); ok {
 		obj.PriorityClassName = v
 	}
@@ -1655,6 +1753,67 @@ func expandReadinessGates(gates []interface{}) []v1.PodReadinessGate {
 	return cs
 }
 
+func expandSchedulingGates(gates []interface{}) []v1.PodSchedulingGate {
+	sg := make([]v1.PodSchedulingGate, 0, len(gates))
+	for _, g := range gates {
+		if g == nil {
+			continue
+		}
+		sg = append(sg, v1.PodSchedulingGate{Name: g.(map[string]interface{})["name"].(string)})
+	}
+	return sg
+}
+
+func expandPodResourceClaims(claims []interface{}) []v1.PodResourceClaim {
+	rc := make([]v1.PodResourceClaim, 0, len(claims))
+	for _, c := range claims {
+		if c == nil {
+			continue
+		}
+		m := c.(map[string]interface{})
+		claim := v1.PodResourceClaim{Name: m["name"].(string)}
+		if s, ok := m["source"].([]interface{}); ok && len(s) > 0 && s[0] != nil {
+			source := s[0].(map[string]interface{})
+			if v, ok := source["resource_claim_name"].(string); ok && v != "" {
+				claim.Source.ResourceClaimName = ptr.To(v)
+			}
+			if v, ok := source["resource_claim_template_name"].(string); ok && v != "" {
+				claim.Source.ResourceClaimTemplateName = ptr.To(v)
+			}
+		}
+		rc = append(rc, claim)
+	}
+	return rc
+}
+
+// suppressRemovedSchedulingGates suppresses the diff of the scheduling gates of a
+// pod when the gates left on the pod are a subset of the configured ones. This is
+// the case when a controller has removed gates, which is how gates are consumed.
+func suppressRemovedSchedulingGates(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	i := strings.LastIndex(k, "scheduling_gate")
+	if i < 0 {
+		return false
+	}
+	o, n := d.GetChange(k[:i] + "scheduling_gate")
+	oldGates, newGates := o.([]interface{}), n.([]interface{})
+	if len(oldGates) >= len(newGates) {
+		return false
+	}
+	configured := make(map[string]bool, len(newGates))
+	for _, g := range expandSchedulingGates(newGates) {
+		configured[g.Name] = true
+	}
+	for _, g := range expandSchedulingGates(oldGates) {
+		if !configured[g.Name] {
+			return false
+		}
+	}
+	return true
+}
+
 func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
@@ -1679,5 +1838,322 @@ func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 		}
 
 	}
+
+	if d.HasChange(prefix + "scheduling_gate") {
+		// Scheduling gates can only be removed from an existing pod, so only keep
+		// the configured gates that are still present.
+		o, n := d.GetChange(prefix + "scheduling_gate")
+		present := make(map[string]bool)
+		for _, g := range expandSchedulingGates(o.([]interface{})) {
+			present[g.Name] = true
+		}
+		gates := make([]v1.PodSchedulingGate, 0)
+		for _, g := range expandSchedulingGates(n.([]interface{})) {
+			if present[g.Name] {
+				gates = append(gates, g)
+			}
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/schedulingGates",
+			Value: gates,
+		})
+	}
 	return ops, nil
 }
+
+// podSpecWindowsWarnings warns about security context fields set on a pod spec
+// with os.name set to windows. The API server rejects these fields for Windows
+// pods, since Windows containers do not run as a numeric user or group.
+func podSpecWindowsWarnings(spec *v1.PodSpec) diag.Diagnostics {
+	if spec == nil || spec.OS == nil || spec.OS.Name != v1.Windows {
+		return nil
+	}
+	fields := make([]string, 0)
+	if sc := spec.SecurityContext; sc != nil {
+		if sc.RunAsUser != nil {
+			fields = append(fields, "security_context.run_as_user")
+		}
+		if sc.RunAsGroup != nil {
+			fields = append(fields, "security_context.run_as_group")
+		}
+	}
+	containerFields := func(kind string, containers []v1.Container) {
+		for _, c := range containers {
+			if c.SecurityContext == nil {
+				continue
+			}
+			if c.SecurityContext.RunAsUser != nil {
+				fields = append(fields, fmt.Sprintf("%s %q security_context.run_as_user", kind, c.Name))
+			}
+			if c.SecurityContext.RunAsGroup != nil {
+				fields = append(fields, fmt.Sprintf("%s %q security_context.run_as_group", kind, c.Name))
+			}
+		}
+	}
+	containerFields("init_container", spec.InitContainers)
+	containerFields("container", spec.Containers)
+	if len(fields) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Security context fields are not supported on Windows",
+		Detail:   fmt.Sprintf("The pod spec sets os.name to %q, but also sets %s. Kubernetes rejects pods for Windows that set run_as_user or run_as_group, use windows_options.run_as_username instead and remove these fields.", v1.Windows, strings.Join(fields, ", ")),
+	}}
+}
+
+// podSpecCustomizeDiff returns a CustomizeDiffFunc that validates the pod spec
+// found under prefix, e.g. "spec.0.template.0.spec.0.".
+func podSpecCustomizeDiff(prefix string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if err := validatePodSpecSeccompProfiles(diff, prefix); err != nil {
+			return err
+		}
+		if err := validatePodSpecSysctls(diff, prefix); err != nil {
+			return err
+		}
+		if err := validatePodSpecVolumeMountSubPaths(diff, prefix); err != nil {
+			return err
+		}
+		if err := validatePodSpecVolumeDevices(diff, prefix); err != nil {
+			return err
+		}
+		return validatePodSpecResourceClaims(diff, prefix, meta)
+	}
+}
+
+// validatePodSpecResourceClaims checks that resource claims of the pod spec
+// found under prefix, and the claims of its containers, are only used when the
+// dynamic_resource_allocation provider experiment is enabled. It also checks
+// that each resource claim has exactly one source.
+func validatePodSpecResourceClaims(diff *schema.ResourceDiff, prefix string, meta interface{}) error {
+	enabled := false
+	if m, ok := meta.(providerMetadata); ok {
+		enabled = m.DynamicResourceAllocation
+	}
+
+	key := prefix + "resource_claim"
+	n, _ := diff.Get(key + ".#").(int)
+	if n > 0 && !enabled {
+		return fmt.Errorf("%s: resource claims require the `dynamic_resource_allocation` provider experiment to be enabled", key)
+	}
+	for i := 0; i < n; i++ {
+		sourceKey := fmt.Sprintf("%s.%d.source.0", key, i)
+		if !diff.NewValueKnown(sourceKey+".resource_claim_name") || !diff.NewValueKnown(sourceKey+".resource_claim_template_name") {
+			continue
+		}
+		claimName, _ := diff.Get(sourceKey + ".resource_claim_name").(string)
+		templateName, _ := diff.Get(sourceKey + ".resource_claim_template_name").(string)
+		if (claimName == "") == (templateName == "") {
+			return fmt.Errorf("%s: exactly one of `resource_claim_name` and `resource_claim_template_name` must be set", sourceKey)
+		}
+	}
+
+	if enabled {
+		return nil
+	}
+	for _, c := range []string{"container", "init_container"} {
+		cn, _ := diff.Get(prefix + c + ".#").(int)
+		for i := 0; i < cn; i++ {
+			claimsKey := fmt.Sprintf("%s%s.%d.resources.0.claims", prefix, c, i)
+			if cc, _ := diff.Get(claimsKey + ".#").(int); cc > 0 {
+				return fmt.Errorf("%s: resource claims require the `dynamic_resource_allocation` provider experiment to be enabled", claimsKey)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePodSpecSysctls checks that each sysctl of the pod spec found under
+// prefix is only set once. Names unknown at plan time are skipped.
+func validatePodSpecSysctls(diff *schema.ResourceDiff, prefix string) error {
+	key := prefix + "security_context.0.sysctl"
+	n, _ := diff.Get(key + ".#").(int)
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		nameKey := fmt.Sprintf("%s.%d.name", key, i)
+		if !diff.NewValueKnown(nameKey) {
+			continue
+		}
+		name := diff.Get(nameKey).(string)
+		if seen[name] {
+			return fmt.Errorf("%s: sysctl %q is set more than once", key, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validatePodSpecVolumeMountSubPaths checks that no volume mount of the
+// containers of the pod spec found under prefix sets both sub_path and
+// sub_path_expr. Mounts with values unknown at plan time are skipped.
+func validatePodSpecVolumeMountSubPaths(diff *schema.ResourceDiff, prefix string) error {
+	for _, kind := range []string{"init_container", "container", "ephemeral_container"} {
+		n, _ := diff.Get(prefix + kind + ".#").(int)
+		for i := 0; i < n; i++ {
+			key := fmt.Sprintf("%s%s.%d.volume_mount", prefix, kind, i)
+			m, _ := diff.Get(key + ".#").(int)
+			for j := 0; j < m; j++ {
+				subPathKey := fmt.Sprintf("%s.%d.sub_path", key, j)
+				subPathExprKey := subPathKey + "_expr"
+				if !diff.NewValueKnown(subPathKey) || !diff.NewValueKnown(subPathExprKey) {
+					continue
+				}
+				if diff.Get(subPathKey).(string) != "" && diff.Get(subPathExprKey).(string) != "" {
+					return fmt.Errorf("%s and %s are mutually exclusive", subPathKey, subPathExprKey)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validatePodSpecVolumeDevices checks that no container of the pod spec found
+// under prefix references the same volume from both a volume_mount and a
+// volume_device. Names unknown at plan time are skipped.
+func validatePodSpecVolumeDevices(diff *schema.ResourceDiff, prefix string) error {
+	for _, kind := range []string{"init_container", "container", "ephemeral_container"} {
+		n, _ := diff.Get(prefix + kind + ".#").(int)
+		for i := 0; i < n; i++ {
+			key := fmt.Sprintf("%s%s.%d", prefix, kind, i)
+			mounts := make(map[string]bool)
+			m, _ := diff.Get(key + ".volume_mount.#").(int)
+			for j := 0; j < m; j++ {
+				nameKey := fmt.Sprintf("%s.volume_mount.%d.name", key, j)
+				if diff.NewValueKnown(nameKey) {
+					mounts[diff.Get(nameKey).(string)] = true
+				}
+			}
+			d, _ := diff.Get(key + ".volume_device.#").(int)
+			for j := 0; j < d; j++ {
+				nameKey := fmt.Sprintf("%s.volume_device.%d.name", key, j)
+				if !diff.NewValueKnown(nameKey) {
+					continue
+				}
+				if name := diff.Get(nameKey).(string); mounts[name] {
+					return fmt.Errorf("%s: volume %q cannot be used by both a volume_mount and a volume_device", key, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validatePodSpecSeccompProfiles checks that localhost_profile is set on the
+// seccomp profiles of the pod spec found under prefix if, and only if, their
+// type is Localhost. Profiles with values unknown at plan time are skipped.
+func validatePodSpecSeccompProfiles(diff *schema.ResourceDiff, prefix string) error {
+	profiles := []string{prefix + "security_context.0.seccomp_profile"}
+	for _, kind := range []string{"init_container", "container", "ephemeral_container"} {
+		n, _ := diff.Get(prefix + kind + ".#").(int)
+		for i := 0; i < n; i++ {
+			profiles = append(profiles, fmt.Sprintf("%s%s.%d.security_context.0.seccomp_profile", prefix, kind, i))
+		}
+	}
+	for _, p := range profiles {
+		if l, ok := diff.Get(p).([]interface{}); !ok || len(l) == 0 || l[0] == nil {
+			continue
+		}
+		typeKey, profileKey := p+".0.type", p+".0.localhost_profile"
+		if !diff.NewValueKnown(typeKey) || !diff.NewValueKnown(profileKey) {
+			continue
+		}
+		isLocalhost := diff.Get(typeKey).(string) == string(v1.SeccompProfileTypeLocalhost)
+		hasProfile := diff.Get(profileKey).(string) != ""
+		if isLocalhost && !hasProfile {
+			return fmt.Errorf("%s must be set when %s is %q", profileKey, typeKey, v1.SeccompProfileTypeLocalhost)
+		}
+		if !isLocalhost && hasProfile {
+			return fmt.Errorf("%s can only be set when %s is %q", profileKey, typeKey, v1.SeccompProfileTypeLocalhost)
+		}
+	}
+	return nil
+}
+
+// checkPodSpecSidecarContainers returns an error when the pod spec declares
+// sidecar containers, i.e. init containers with restart_policy set to Always,
+// and the cluster is older than 1.29, which does not support them.
+func checkPodSpecSidecarContainers(conn *kubernetes.Clientset, spec *v1.PodSpec) error {
+	sidecars := make([]string, 0)
+	for _, c := range spec.InitContainers {
+		if c.RestartPolicy != nil && *c.RestartPolicy == v1.ContainerRestartPolicyAlways {
+			sidecars = append(sidecars, fmt.Sprintf("%q", c.Name))
+		}
+	}
+	if len(sidecars) == 0 {
+		return nil
+	}
+	supported, err := serverVersionGreaterThanOrEqual(conn, "1.29.0")
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the server version: %s", err)
+		return nil
+	}
+	if !supported {
+		return fmt.Errorf("the init containers %s set restart_policy to %q, which requires Kubernetes 1.29 or later", strings.Join(sidecars, ", "), v1.ContainerRestartPolicyAlways)
+	}
+	return nil
+}
+
+// podSpecEphemeralVolumeWarnings warns about generic ephemeral volumes declared
+// in a pod spec. Their persistent volume claims are owned by the pod and are
+// garbage collected together with it.
+func podSpecEphemeralVolumeWarnings(spec *v1.PodSpec) diag.Diagnostics {
+	if spec == nil {
+		return nil
+	}
+	names := make([]string, 0)
+	for _, v := range spec.Volumes {
+		if v.Ephemeral != nil {
+			names = append(names, fmt.Sprintf("%q", v.Name))
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Ephemeral volumes are deleted with the pod",
+		Detail:   fmt.Sprintf("The pod spec declares the ephemeral volumes %s. Kubernetes deletes the persistent volume claims of ephemeral volumes when their pod is deleted, so their data does not outlive the pod. Use a kubernetes_persistent_volume_claim_v1 for data that must be kept.", strings.Join(names, ", ")),
+	}}
+}
+
+// topologySpreadInclusionPolicyFields returns the node inclusion policy fields
+// set on the topology spread constraints of a pod spec.
+func topologySpreadInclusionPolicyFields(spec *v1.PodSpec) []string {
+	fields := make([]string, 0)
+	if spec == nil {
+		return fields
+	}
+	for i, c := range spec.TopologySpreadConstraints {
+		if c.NodeAffinityPolicy != nil {
+			fields = append(fields, fmt.Sprintf("topology_spread_constraint.%d.node_affinity_policy", i))
+		}
+		if c.NodeTaintsPolicy != nil {
+			fields = append(fields, fmt.Sprintf("topology_spread_constraint.%d.node_taints_policy", i))
+		}
+	}
+	return fields
+}
+
+// podSpecTopologySpreadWarnings warns about node inclusion policies set on the
+// topology spread constraints of a pod spec when the cluster is older than
+// 1.26, since these clusters silently drop the fields.
+func podSpecTopologySpreadWarnings(conn *kubernetes.Clientset, spec *v1.PodSpec) diag.Diagnostics {
+	fields := topologySpreadInclusionPolicyFields(spec)
+	if len(fields) == 0 {
+		return nil
+	}
+	supported, err := serverVersionGreaterThanOrEqual(conn, "1.26.0")
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the server version: %s", err)
+		return nil
+	}
+	if supported {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Topology spread node inclusion policies are not supported by this cluster",
+		Detail:   fmt.Sprintf("The pod spec sets %s, which require Kubernetes 1.26 or later. Older clusters ignore these fields.", strings.Join(fields, ", ")),
+	}}
+}
@@ -4,10 +4,14 @@
 package kubernetes
 
 import (
+	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
@@ -698,3 +702,473 @@ func TestFlattenCSIVolumeSource(t *testing.T) {
 		}
 	}
 }
+
+func TestSchedulingGatesRoundTrip(t *testing.T) {
+	gates := []corev1.PodSchedulingGate{
+		{Name: "example.com/first"},
+		{Name: "second"},
+	}
+	out := expandSchedulingGates(flattenSchedulingGates(gates))
+	if diff := cmp.Diff(gates, out); diff != "" {
+		t.Fatalf("scheduling gates do not survive a round trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodSpecWindowsWarnings(t *testing.T) {
+	windows := &corev1.PodOS{Name: corev1.Windows}
+	cases := map[string]struct {
+		spec *corev1.PodSpec
+		warn bool
+	}{
+		"linux": {
+			spec: &corev1.PodSpec{
+				OS:              &corev1.PodOS{Name: corev1.Linux},
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000))},
+			},
+		},
+		"windows": {
+			spec: &corev1.PodSpec{
+				OS: windows,
+				SecurityContext: &corev1.PodSecurityContext{
+					WindowsOptions: &corev1.WindowsSecurityContextOptions{RunAsUserName: ptr.To("ContainerUser")},
+				},
+			},
+		},
+		"windows with pod run_as_user": {
+			spec: &corev1.PodSpec{
+				OS:              windows,
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000))},
+			},
+			warn: true,
+		},
+		"windows with container run_as_group": {
+			spec: &corev1.PodSpec{
+				OS: windows,
+				Containers: []corev1.Container{
+					{Name: "app", SecurityContext: &corev1.SecurityContext{RunAsGroup: ptr.To(int64(1000))}},
+				},
+			},
+			warn: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := podSpecWindowsWarnings(tc.spec)
+			if tc.warn && len(diags) != 1 {
+				t.Fatalf("expected a warning, got: %#v", diags)
+			}
+			if !tc.warn && len(diags) != 0 {
+				t.Fatalf("unexpected warning: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestTopologySpreadInclusionPolicyFields(t *testing.T) {
+	spec := &corev1.PodSpec{
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{TopologyKey: "topology.kubernetes.io/zone", MaxSkew: 1},
+			{
+				TopologyKey:        "kubernetes.io/hostname",
+				MaxSkew:            1,
+				NodeAffinityPolicy: ptr.To(corev1.NodeInclusionPolicyHonor),
+				NodeTaintsPolicy:   ptr.To(corev1.NodeInclusionPolicyIgnore),
+			},
+		},
+	}
+	expected := []string{
+		"topology_spread_constraint.1.node_affinity_policy",
+		"topology_spread_constraint.1.node_taints_policy",
+	}
+	if diff := cmp.Diff(expected, topologySpreadInclusionPolicyFields(spec)); diff != "" {
+		t.Fatalf("unexpected fields (-want +got):\n%s", diff)
+	}
+	if fields := topologySpreadInclusionPolicyFields(&corev1.PodSpec{}); len(fields) != 0 {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+}
+
+func TestPodSpecEphemeralVolumeWarnings(t *testing.T) {
+	spec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+		},
+	}
+	if diags := podSpecEphemeralVolumeWarnings(spec); len(diags) != 0 {
+		t.Fatalf("unexpected warning: %#v", diags)
+	}
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name:         "scratch",
+		VolumeSource: corev1.VolumeSource{Ephemeral: &corev1.EphemeralVolumeSource{}},
+	})
+	if diags := podSpecEphemeralVolumeWarnings(spec); len(diags) != 1 {
+		t.Fatalf("expected a warning, got: %#v", diags)
+	}
+}
+
+func TestPodSpecOverheadRoundTrip(t *testing.T) {
+	in := corev1.PodSpec{
+		Overhead: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("120Mi"),
+		},
+	}
+
+	flattened, err := flattenPodSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPodSchemaV1(), map[string]interface{}{})
+	if err := d.Set("spec", flattened); err != nil {
+		t.Fatal(err)
+	}
+	out, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(in.Overhead, out.Overhead); diff != "" {
+		t.Fatalf("overhead does not survive a round trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidatePodSpecSeccompProfiles(t *testing.T) {
+	cases := map[string]struct {
+		podProfile       map[string]interface{}
+		containerProfile map[string]interface{}
+		err              string
+	}{
+		"runtime default": {
+			podProfile: map[string]interface{}{"type": "RuntimeDefault"},
+		},
+		"localhost with profile": {
+			containerProfile: map[string]interface{}{"type": "Localhost", "localhost_profile": "profiles/audit.json"},
+		},
+		"localhost without profile": {
+			podProfile: map[string]interface{}{"type": "Localhost"},
+			err:        "spec.0.security_context.0.seccomp_profile.0.localhost_profile must be set",
+		},
+		"profile without localhost": {
+			containerProfile: map[string]interface{}{"type": "RuntimeDefault", "localhost_profile": "profiles/audit.json"},
+			err:              "spec.0.container.0.security_context.0.seccomp_profile.0.localhost_profile can only be set",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			container := map[string]interface{}{"name": "app", "image": "busybox"}
+			if tc.containerProfile != nil {
+				container["security_context"] = []interface{}{map[string]interface{}{"seccomp_profile": []interface{}{tc.containerProfile}}}
+			}
+			spec := map[string]interface{}{"container": []interface{}{container}}
+			if tc.podProfile != nil {
+				spec["security_context"] = []interface{}{map[string]interface{}{"seccomp_profile": []interface{}{tc.podProfile}}}
+			}
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec":     []interface{}{spec},
+			}
+			_, err := resourceKubernetesPodV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("expected error containing %q, got: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidatePodSpecSysctls(t *testing.T) {
+	cases := map[string]struct {
+		sysctls []interface{}
+		err     bool
+	}{
+		"unique": {
+			sysctls: []interface{}{
+				map[string]interface{}{"name": "net.core.somaxconn", "value": "1024"},
+				map[string]interface{}{"name": "kernel.shm_rmid_forced", "value": "0"},
+			},
+		},
+		"duplicate": {
+			sysctls: []interface{}{
+				map[string]interface{}{"name": "net.core.somaxconn", "value": "1024"},
+				map[string]interface{}{"name": "net.core.somaxconn", "value": "2048"},
+			},
+			err: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"container":        []interface{}{map[string]interface{}{"name": "app", "image": "busybox"}},
+					"security_context": []interface{}{map[string]interface{}{"sysctl": tc.sysctls}},
+				}},
+			}
+			_, err := resourceKubernetesPodV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err && (err == nil || !strings.Contains(err.Error(), `sysctl "net.core.somaxconn" is set more than once`)) {
+				t.Fatalf("expected a duplicate sysctl error, got: %v", err)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidatePodSpecVolumeMountSubPaths(t *testing.T) {
+	cases := map[string]struct {
+		mount map[string]interface{}
+		err   bool
+	}{
+		"sub_path": {
+			mount: map[string]interface{}{"name": "data", "mount_path": "/data", "sub_path": "app"},
+		},
+		"sub_path_expr": {
+			mount: map[string]interface{}{"name": "data", "mount_path": "/data", "sub_path_expr": "$(POD_NAME)"},
+		},
+		"both": {
+			mount: map[string]interface{}{"name": "data", "mount_path": "/data", "sub_path": "app", "sub_path_expr": "$(POD_NAME)"},
+			err:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"container": []interface{}{map[string]interface{}{
+						"name":         "app",
+						"image":        "busybox",
+						"volume_mount": []interface{}{tc.mount},
+					}},
+					"volume": []interface{}{map[string]interface{}{
+						"name":      "data",
+						"empty_dir": []interface{}{map[string]interface{}{}},
+					}},
+				}},
+			}
+			_, err := resourceKubernetesPodV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err && (err == nil || !strings.Contains(err.Error(), "are mutually exclusive")) {
+				t.Fatalf("expected a mutually exclusive error, got: %v", err)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidatePodSpecVolumeDevices(t *testing.T) {
+	cases := map[string]struct {
+		mounts  []interface{}
+		devices []interface{}
+		err     bool
+	}{
+		"device only": {
+			devices: []interface{}{map[string]interface{}{"name": "block", "device_path": "/dev/xvda"}},
+		},
+		"distinct volumes": {
+			mounts:  []interface{}{map[string]interface{}{"name": "data", "mount_path": "/data"}},
+			devices: []interface{}{map[string]interface{}{"name": "block", "device_path": "/dev/xvda"}},
+		},
+		"same volume": {
+			mounts:  []interface{}{map[string]interface{}{"name": "block", "mount_path": "/data"}},
+			devices: []interface{}{map[string]interface{}{"name": "block", "device_path": "/dev/xvda"}},
+			err:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec": []interface{}{map[string]interface{}{
+					"container": []interface{}{map[string]interface{}{
+						"name":          "app",
+						"image":         "busybox",
+						"volume_mount":  tc.mounts,
+						"volume_device": tc.devices,
+					}},
+				}},
+			}
+			_, err := resourceKubernetesPodV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.err && (err == nil || !strings.Contains(err.Error(), `volume "block" cannot be used by both a volume_mount and a volume_device`)) {
+				t.Fatalf("expected a volume conflict error, got: %v", err)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestPodV1ResizeCustomizeDiff(t *testing.T) {
+	config := func(cpu string) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+			"spec": []interface{}{map[string]interface{}{
+				"container": []interface{}{map[string]interface{}{
+					"name":  "app",
+					"image": "busybox",
+					"resources": []interface{}{map[string]interface{}{
+						"limits": map[string]interface{}{"cpu": cpu},
+					}},
+				}},
+			}},
+		}
+	}
+	r := resourceKubernetesPodV1()
+	d := schema.TestResourceDataRaw(t, r.Schema, config("500m"))
+	d.SetId("default/test")
+
+	// Without a cluster to ask, the provider falls back to replacing the pod.
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config("1")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a change of container resources to replace the pod, got: %#v", diff)
+	}
+	attr, ok := diff.Attributes["spec.0.container.0.resources.0.limits.cpu"]
+	if !ok || attr.New != "1" {
+		t.Fatalf("expected the cpu limit to change, got: %#v", diff.Attributes)
+	}
+}
+
+func TestPodV1ContainerResizableInPlace(t *testing.T) {
+	resources := func(limits, requests map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"limits": limits, "requests": requests}}
+	}
+	restartOnMemory := []interface{}{map[string]interface{}{"resource_name": "memory", "restart_policy": "RestartContainer"}}
+	cases := map[string]struct {
+		old, new     []interface{}
+		resizePolicy []interface{}
+		resizable    bool
+	}{
+		"cpu and memory": {
+			old:       resources(map[string]interface{}{"cpu": "500m", "memory": "128Mi"}, map[string]interface{}{"cpu": "250m", "memory": "64Mi"}),
+			new:       resources(map[string]interface{}{"cpu": "1", "memory": "256Mi"}, map[string]interface{}{"cpu": "500m", "memory": "128Mi"}),
+			resizable: true,
+		},
+		"guaranteed stays guaranteed": {
+			old:       resources(map[string]interface{}{"cpu": "500m"}, map[string]interface{}{"cpu": "500m"}),
+			new:       resources(map[string]interface{}{"cpu": "1"}, map[string]interface{}{"cpu": "1"}),
+			resizable: true,
+		},
+		"guaranteed becomes burstable": {
+			old: resources(map[string]interface{}{"cpu": "500m"}, map[string]interface{}{"cpu": "500m"}),
+			new: resources(map[string]interface{}{"cpu": "1"}, map[string]interface{}{"cpu": "500m"}),
+		},
+		"resource added": {
+			old: resources(map[string]interface{}{"cpu": "500m"}, nil),
+			new: resources(map[string]interface{}{"cpu": "500m", "memory": "128Mi"}, nil),
+		},
+		"ephemeral storage": {
+			old: resources(map[string]interface{}{"ephemeral-storage": "128Mi"}, nil),
+			new: resources(map[string]interface{}{"ephemeral-storage": "256Mi"}, nil),
+		},
+		"memory limit decrease": {
+			old: resources(map[string]interface{}{"memory": "256Mi"}, map[string]interface{}{"memory": "64Mi"}),
+			new: resources(map[string]interface{}{"memory": "128Mi"}, map[string]interface{}{"memory": "64Mi"}),
+		},
+		"memory limit decrease restarting the container": {
+			old:          resources(map[string]interface{}{"memory": "256Mi"}, map[string]interface{}{"memory": "64Mi"}),
+			new:          resources(map[string]interface{}{"memory": "128Mi"}, map[string]interface{}{"memory": "64Mi"}),
+			resizePolicy: restartOnMemory,
+			resizable:    true,
+		},
+		"memory request decrease": {
+			old:       resources(map[string]interface{}{"memory": "256Mi"}, map[string]interface{}{"memory": "128Mi"}),
+			new:       resources(map[string]interface{}{"memory": "256Mi"}, map[string]interface{}{"memory": "64Mi"}),
+			resizable: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := podV1ContainerResizableInPlace(tc.old, tc.new, tc.resizePolicy); got != tc.resizable {
+				t.Fatalf("expected resizable to be %t, got %t", tc.resizable, got)
+			}
+		})
+	}
+}
+
+func TestPodResourceClaimsRoundTrip(t *testing.T) {
+	claims := []corev1.PodResourceClaim{
+		{Name: "gpu", Source: corev1.ClaimSource{ResourceClaimName: ptr.To("shared-gpu")}},
+		{Name: "fpga", Source: corev1.ClaimSource{ResourceClaimTemplateName: ptr.To("fpga-template")}},
+	}
+	out := expandPodResourceClaims(flattenPodResourceClaims(claims))
+	if diff := cmp.Diff(claims, out); diff != "" {
+		t.Fatalf("resource claims do not survive a round trip (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidatePodSpecResourceClaims(t *testing.T) {
+	gpuClaim := map[string]interface{}{
+		"name":   "gpu",
+		"source": []interface{}{map[string]interface{}{"resource_claim_template_name": "gpu-template"}},
+	}
+	cases := map[string]struct {
+		resourceClaims []interface{}
+		claims         []interface{}
+		enabled        bool
+		err            string
+	}{
+		"none": {},
+		"disabled with resource claim": {
+			resourceClaims: []interface{}{gpuClaim},
+			err:            "dynamic_resource_allocation",
+		},
+		"disabled with container claim": {
+			claims: []interface{}{map[string]interface{}{"name": "gpu"}},
+			err:    "dynamic_resource_allocation",
+		},
+		"enabled": {
+			resourceClaims: []interface{}{gpuClaim},
+			claims:         []interface{}{map[string]interface{}{"name": "gpu"}},
+			enabled:        true,
+		},
+		"enabled with both sources": {
+			resourceClaims: []interface{}{map[string]interface{}{
+				"name": "gpu",
+				"source": []interface{}{map[string]interface{}{
+					"resource_claim_name":          "shared-gpu",
+					"resource_claim_template_name": "gpu-template",
+				}},
+			}},
+			enabled: true,
+			err:     "exactly one of",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			container := map[string]interface{}{"name": "app", "image": "busybox"}
+			if tc.claims != nil {
+				container["resources"] = []interface{}{map[string]interface{}{"claims": tc.claims}}
+			}
+			spec := map[string]interface{}{"container": []interface{}{container}}
+			if tc.resourceClaims != nil {
+				spec["resource_claim"] = tc.resourceClaims
+			}
+			raw := map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+				"spec":     []interface{}{spec},
+			}
+			meta := providerMetadata{DynamicResourceAllocation: tc.enabled}
+			_, err := resourceKubernetesPodV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), meta)
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("expected an error containing %q, got: %v", tc.err, err)
+			}
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+)
+
+// Expanders
+
+func expandResourceClaimV1Alpha2Spec(l []interface{}) resourcev1alpha2.ResourceClaimSpec {
+	obj := resourcev1alpha2.ResourceClaimSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.ResourceClassName = in["resource_class_name"].(string)
+	if v, ok := in["allocation_mode"].(string); ok && v != "" {
+		obj.AllocationMode = resourcev1alpha2.AllocationMode(v)
+	}
+	if v, ok := in["parameters_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		obj.ParametersRef = &resourcev1alpha2.ResourceClaimParametersReference{
+			APIGroup: p["api_group"].(string),
+			Kind:     p["kind"].(string),
+			Name:     p["name"].(string),
+		}
+	}
+
+	return obj
+}
+
+// Flatteners
+
+func flattenResourceClaimV1Alpha2Spec(in resourcev1alpha2.ResourceClaimSpec) []interface{} {
+	att := map[string]interface{}{
+		"resource_class_name": in.ResourceClassName,
+		"allocation_mode":     string(in.AllocationMode),
+	}
+	if in.ParametersRef != nil {
+		att["parameters_ref"] = []interface{}{map[string]interface{}{
+			"api_group": in.ParametersRef.APIGroup,
+			"kind":      in.ParametersRef.Kind,
+			"name":      in.ParametersRef.Name,
+		}}
+	}
+	return []interface{}{att}
+}
+
+func flattenResourceClaimV1Alpha2Status(in resourcev1alpha2.ResourceClaimStatus) []interface{} {
+	att := map[string]interface{}{
+		"driver_name":            in.DriverName,
+		"deallocation_requested": in.DeallocationRequested,
+	}
+
+	if in.Allocation != nil {
+		handles := make([]interface{}, len(in.Allocation.ResourceHandles))
+		for i, h := range in.Allocation.ResourceHandles {
+			handles[i] = map[string]interface{}{
+				"driver_name": h.DriverName,
+				"data":        h.Data,
+			}
+		}
+		allocation := map[string]interface{}{
+			"resource_handle": handles,
+			"shareable":       in.Allocation.Shareable,
+		}
+		if in.Allocation.AvailableOnNodes != nil {
+			allocation["available_on_nodes"] = flattenNodeSelector(in.Allocation.AvailableOnNodes)
+		}
+		att["allocation"] = []interface{}{allocation}
+	}
+
+	reservedFor := make([]interface{}, len(in.ReservedFor))
+	for i, r := range in.ReservedFor {
+		reservedFor[i] = map[string]interface{}{
+			"api_group": r.APIGroup,
+			"resource":  r.Resource,
+			"name":      r.Name,
+			"uid":       string(r.UID),
+		}
+	}
+	att["reserved_for"] = reservedFor
+
+	return []interface{}{att}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	nodev1 "k8s.io/api/node/v1"
+)
+
+func expandRuntimeClassV1Overhead(l []interface{}) (*nodev1.Overhead, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &nodev1.Overhead{}
+
+	if v, ok := in["pod_fixed"].(map[string]interface{}); ok && len(v) > 0 {
+		rl, err := expandMapToResourceList(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.PodFixed = *rl
+	}
+
+	return obj, nil
+}
+
+func flattenRuntimeClassV1Overhead(in *nodev1.Overhead) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"pod_fixed": flattenResourceList(in.PodFixed),
+	}
+	return []interface{}{att}
+}
+
+func expandRuntimeClassV1Scheduling(l []interface{}) (*nodev1.Scheduling, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &nodev1.Scheduling{}
+
+	if v, ok := in["node_selector"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.NodeSelector = expandStringMap(v)
+	}
+
+	if v, ok := in["toleration"].([]interface{}); ok && len(v) > 0 {
+		ts, err := expandTolerations(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range ts {
+			obj.Tolerations = append(obj.Tolerations, *t)
+		}
+	}
+
+	return obj, nil
+}
+
+func flattenRuntimeClassV1Scheduling(in *nodev1.Scheduling) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"node_selector": in.NodeSelector,
+		"toleration":    flattenTolerations(in.Tolerations),
+	}
+	return []interface{}{att}
+}
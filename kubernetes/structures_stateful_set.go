@@ -189,6 +189,20 @@ func flattenStatefulSetSpec(spec v1.StatefulSetSpec, d *schema.ResourceData, met
 	return []interface{}{att}, nil
 }
 
+func flattenStatefulSetV1Status(in v1.StatefulSetStatus) []interface{} {
+	att := map[string]interface{}{
+		"observed_generation": int(in.ObservedGeneration),
+		"replicas":            int(in.Replicas),
+		"ready_replicas":      int(in.ReadyReplicas),
+		"current_replicas":    int(in.CurrentReplicas),
+		"updated_replicas":    int(in.UpdatedReplicas),
+		"available_replicas":  int(in.AvailableReplicas),
+		"current_revision":    in.CurrentRevision,
+		"update_revision":     in.UpdateRevision,
+	}
+	return []interface{}{att}
+}
+
 func flattenPodTemplateSpec(t corev1.PodTemplateSpec) ([]interface{}, error) {
 	template := make(map[string]interface{})
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -269,6 +270,37 @@ func TestFlattenMetadata(t *testing.T) {
 				"uid":              uid,
 			}},
 		},
+		"LastAppliedConfiguration": {
+			metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"v1","kind":"ConfigMap"}`,
+					"foo.example.com": "bar",
+				},
+				Generation: 1,
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				Name:            "foo",
+				ResourceVersion: "1",
+				UID:             types.UID(uid),
+			},
+			providerMetadata{
+				IgnoreAnnotations: []string{},
+				IgnoreLabels:      []string{},
+			},
+			[]interface{}{map[string]interface{}{
+				"annotations": map[string]string{
+					"foo.example.com": "bar",
+				},
+				"generation": int64(1),
+				"labels": map[string]string{
+					"foo": "bar",
+				},
+				"name":             "foo",
+				"resource_version": "1",
+				"uid":              uid,
+			}},
+		},
 	}
 	rawData := map[string]interface{}{
 		"metadata": []interface{}{map[string]interface{}{
@@ -290,3 +322,28 @@ func TestFlattenMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveGeneratedServiceAccountTokens(t *testing.T) {
+	t.Parallel()
+
+	in := []api.ObjectReference{
+		{Name: "foo-token-abcde"},
+		{Name: "foo-token-fghij"},
+		{Name: "foo-secret"},
+		{Name: "bar-token-abcde"},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"name": "foo-token-fghij"},
+		map[string]interface{}{"name": "foo-secret"},
+	}
+	expected := []api.ObjectReference{
+		{Name: "foo-token-fghij"},
+		{Name: "foo-secret"},
+		{Name: "bar-token-abcde"},
+	}
+
+	out := removeGeneratedServiceAccountTokens(in, "foo", configured)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Error matching output and expected: %#v vs %#v", out, expected)
+	}
+}
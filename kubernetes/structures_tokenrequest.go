@@ -4,12 +4,32 @@
 package kubernetes
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	authv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 )
 
+// tokenRequestV1ExpirationWarning returns a warning when the API server
+// issued a token that expires noticeably earlier than requested, which
+// happens when the requested duration exceeds the maximum token expiration
+// configured on the API server.
+func tokenRequestV1ExpirationWarning(requested *int64, requestedAt, expiration time.Time) string {
+	if requested == nil || expiration.IsZero() {
+		return ""
+	}
+	want := time.Duration(*requested) * time.Second
+	got := expiration.Sub(requestedAt)
+	if got >= want-time.Minute {
+		return ""
+	}
+	return fmt.Sprintf("Requested a token valid for %s, but the API server issued one that expires at %s (after about %s). The API server caps the validity of service account tokens, so expiration_seconds should not exceed that limit.",
+		want, expiration.Format(time.RFC3339), got.Round(time.Second))
+}
+
 // Flatteners
 
 func flattenTokenRequestV1Spec(in authv1.TokenRequestSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
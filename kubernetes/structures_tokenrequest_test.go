@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestTokenRequestV1ExpirationWarning(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		requested  *int64
+		expiration time.Time
+		warn       bool
+	}{
+		"NotRequested": {
+			requested:  nil,
+			expiration: now.Add(time.Hour),
+		},
+		"AsRequested": {
+			requested:  ptr.To(int64(3600)),
+			expiration: now.Add(time.Hour + 2*time.Second),
+		},
+		"Extended": {
+			requested:  ptr.To(int64(3600)),
+			expiration: now.Add(365 * 24 * time.Hour),
+		},
+		"Capped": {
+			requested:  ptr.To(int64(48 * 3600)),
+			expiration: now.Add(24 * time.Hour),
+			warn:       true,
+		},
+		"NoExpiration": {
+			requested: ptr.To(int64(3600)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := tokenRequestV1ExpirationWarning(tc.requested, now, tc.expiration)
+			if tc.warn && w == "" {
+				t.Fatal("expected a warning")
+			}
+			if !tc.warn && w != "" {
+				t.Fatalf("unexpected warning: %s", w)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The vertical pod autoscaler is a custom resource installed together with
+// the autoscaler, so k8s.io/api has no types for it. The types below cover
+// the fields managed by the provider and are converted from and to
+// unstructured objects for use with the dynamic client.
+
+var verticalPodAutoscalerV1GroupVersionResource = apimachineryschema.GroupVersionResource{
+	Group:    "autoscaling.k8s.io",
+	Version:  "v1",
+	Resource: "verticalpodautoscalers",
+}
+
+type verticalPodAutoscalerV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   verticalPodAutoscalerV1Spec    `json:"spec"`
+	Status *verticalPodAutoscalerV1Status `json:"status,omitempty"`
+}
+
+type verticalPodAutoscalerV1Spec struct {
+	TargetRef      *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	UpdatePolicy   *verticalPodAutoscalerV1UpdatePolicy       `json:"updatePolicy,omitempty"`
+	ResourcePolicy *verticalPodAutoscalerV1ResourcePolicy     `json:"resourcePolicy,omitempty"`
+}
+
+type verticalPodAutoscalerV1UpdatePolicy struct {
+	UpdateMode *string `json:"updateMode,omitempty"`
+}
+
+type verticalPodAutoscalerV1ResourcePolicy struct {
+	ContainerPolicies []verticalPodAutoscalerV1ContainerPolicy `json:"containerPolicies,omitempty"`
+}
+
+type verticalPodAutoscalerV1ContainerPolicy struct {
+	ContainerName string              `json:"containerName,omitempty"`
+	Mode          *string             `json:"mode,omitempty"`
+	MinAllowed    corev1.ResourceList `json:"minAllowed,omitempty"`
+	MaxAllowed    corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+type verticalPodAutoscalerV1Status struct {
+	Recommendation *verticalPodAutoscalerV1Recommendation `json:"recommendation,omitempty"`
+}
+
+type verticalPodAutoscalerV1Recommendation struct {
+	ContainerRecommendations []verticalPodAutoscalerV1ContainerRecommendation `json:"containerRecommendations,omitempty"`
+}
+
+type verticalPodAutoscalerV1ContainerRecommendation struct {
+	ContainerName  string              `json:"containerName,omitempty"`
+	Target         corev1.ResourceList `json:"target"`
+	LowerBound     corev1.ResourceList `json:"lowerBound,omitempty"`
+	UpperBound     corev1.ResourceList `json:"upperBound,omitempty"`
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+func verticalPodAutoscalerV1ToUnstructured(vpa *verticalPodAutoscalerV1) (*unstructured.Unstructured, error) {
+	vpa.APIVersion = verticalPodAutoscalerV1GroupVersionResource.GroupVersion().String()
+	vpa.Kind = "VerticalPodAutoscaler"
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(vpa)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+func verticalPodAutoscalerV1FromUnstructured(u *unstructured.Unstructured) (*verticalPodAutoscalerV1, error) {
+	vpa := &verticalPodAutoscalerV1{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), vpa)
+	if err != nil {
+		return nil, err
+	}
+	return vpa, nil
+}
+
+// Expanders
+
+func expandVerticalPodAutoscalerV1Spec(l []interface{}) (verticalPodAutoscalerV1Spec, error) {
+	obj := verticalPodAutoscalerV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["target_ref"].([]interface{}); ok && len(v) > 0 {
+		ref := expandCrossVersionObjectReference(v)
+		obj.TargetRef = &ref
+	}
+	if v, ok := in["update_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		obj.UpdatePolicy = &verticalPodAutoscalerV1UpdatePolicy{}
+		if mode, ok := p["update_mode"].(string); ok && mode != "" {
+			obj.UpdatePolicy.UpdateMode = &mode
+		}
+	}
+	if v, ok := in["resource_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		policies, err := expandVerticalPodAutoscalerV1ContainerPolicies(p["container_policies"].([]interface{}))
+		if err != nil {
+			return obj, err
+		}
+		obj.ResourcePolicy = &verticalPodAutoscalerV1ResourcePolicy{
+			ContainerPolicies: policies,
+		}
+	}
+
+	return obj, nil
+}
+
+func expandVerticalPodAutoscalerV1ContainerPolicies(l []interface{}) ([]verticalPodAutoscalerV1ContainerPolicy, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	obj := make([]verticalPodAutoscalerV1ContainerPolicy, 0, len(l))
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+		in := v.(map[string]interface{})
+		p := verticalPodAutoscalerV1ContainerPolicy{}
+		if name, ok := in["container_name"].(string); ok {
+			p.ContainerName = name
+		}
+		if mode, ok := in["mode"].(string); ok && mode != "" {
+			p.Mode = &mode
+		}
+		if m, ok := in["min_allowed"].(map[string]interface{}); ok && len(m) > 0 {
+			rl, err := expandMapToResourceList(m)
+			if err != nil {
+				return nil, err
+			}
+			p.MinAllowed = *rl
+		}
+		if m, ok := in["max_allowed"].(map[string]interface{}); ok && len(m) > 0 {
+			rl, err := expandMapToResourceList(m)
+			if err != nil {
+				return nil, err
+			}
+			p.MaxAllowed = *rl
+		}
+		obj = append(obj, p)
+	}
+	return obj, nil
+}
+
+// Flatteners
+
+func flattenVerticalPodAutoscalerV1Spec(in verticalPodAutoscalerV1Spec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.TargetRef != nil {
+		att["target_ref"] = flattenCrossVersionObjectReference(*in.TargetRef)
+	}
+	if in.UpdatePolicy != nil {
+		p := make(map[string]interface{})
+		if in.UpdatePolicy.UpdateMode != nil {
+			p["update_mode"] = *in.UpdatePolicy.UpdateMode
+		}
+		att["update_policy"] = []interface{}{p}
+	}
+	if in.ResourcePolicy != nil {
+		policies := make([]interface{}, 0, len(in.ResourcePolicy.ContainerPolicies))
+		for _, cp := range in.ResourcePolicy.ContainerPolicies {
+			p := map[string]interface{}{
+				"container_name": cp.ContainerName,
+			}
+			if cp.Mode != nil {
+				p["mode"] = *cp.Mode
+			}
+			if len(cp.MinAllowed) > 0 {
+				p["min_allowed"] = flattenResourceList(cp.MinAllowed)
+			}
+			if len(cp.MaxAllowed) > 0 {
+				p["max_allowed"] = flattenResourceList(cp.MaxAllowed)
+			}
+			policies = append(policies, p)
+		}
+		att["resource_policy"] = []interface{}{map[string]interface{}{
+			"container_policies": policies,
+		}}
+	}
+
+	return []interface{}{att}
+}
+
+func flattenVerticalPodAutoscalerV1Status(in *verticalPodAutoscalerV1Status) []interface{} {
+	recommendations := []interface{}{}
+	if in != nil && in.Recommendation != nil {
+		for _, r := range in.Recommendation.ContainerRecommendations {
+			recommendations = append(recommendations, map[string]interface{}{
+				"container_name":  r.ContainerName,
+				"target":          flattenResourceList(r.Target),
+				"lower_bound":     flattenResourceList(r.LowerBound),
+				"upper_bound":     flattenResourceList(r.UpperBound),
+				"uncapped_target": flattenResourceList(r.UncappedTarget),
+			})
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"recommendation": []interface{}{map[string]interface{}{
+			"container_recommendations": recommendations,
+		}},
+	}}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestVerticalPodAutoscalerV1SpecRoundTrip(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"target_ref": []interface{}{map[string]interface{}{
+			"api_version": "apps/v1",
+			"kind":        "Deployment",
+			"name":        "app",
+		}},
+		"update_policy": []interface{}{map[string]interface{}{
+			"update_mode": "Initial",
+		}},
+		"resource_policy": []interface{}{map[string]interface{}{
+			"container_policies": []interface{}{map[string]interface{}{
+				"container_name": "*",
+				"mode":           "Auto",
+				"min_allowed":    map[string]interface{}{"cpu": "100m"},
+				"max_allowed":    map[string]interface{}{"memory": "1Gi"},
+			}},
+		}},
+	}}
+
+	spec, err := expandVerticalPodAutoscalerV1Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := verticalPodAutoscalerV1ToUnstructured(&verticalPodAutoscalerV1{Spec: spec})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.GetAPIVersion(); got != "autoscaling.k8s.io/v1" {
+		t.Errorf("Unexpected API version %q", got)
+	}
+	if got := u.GetKind(); got != "VerticalPodAutoscaler" {
+		t.Errorf("Unexpected kind %q", got)
+	}
+	policies, _, _ := unstructured.NestedSlice(u.Object, "spec", "resourcePolicy", "containerPolicies")
+	if len(policies) != 1 {
+		t.Fatalf("Expected one container policy, got %#v", policies)
+	}
+	if cpu, _, _ := unstructured.NestedString(policies[0].(map[string]interface{}), "minAllowed", "cpu"); cpu != "100m" {
+		t.Errorf("Unexpected minAllowed cpu %q", cpu)
+	}
+
+	out, err := verticalPodAutoscalerV1FromUnstructured(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{map[string]interface{}{
+		"target_ref": []interface{}{map[string]interface{}{
+			"api_version": "apps/v1",
+			"kind":        "Deployment",
+			"name":        "app",
+		}},
+		"update_policy": []interface{}{map[string]interface{}{
+			"update_mode": "Initial",
+		}},
+		"resource_policy": []interface{}{map[string]interface{}{
+			"container_policies": []interface{}{map[string]interface{}{
+				"container_name": "*",
+				"mode":           "Auto",
+				"min_allowed":    map[string]string{"cpu": "100m"},
+				"max_allowed":    map[string]string{"memory": "1Gi"},
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(expected, flattenVerticalPodAutoscalerV1Spec(out.Spec)); diff != "" {
+		t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenVerticalPodAutoscalerV1Status(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"status": map[string]interface{}{
+			"recommendation": map[string]interface{}{
+				"containerRecommendations": []interface{}{map[string]interface{}{
+					"containerName":  "app",
+					"target":         map[string]interface{}{"cpu": "25m", "memory": "262144k"},
+					"lowerBound":     map[string]interface{}{"cpu": "25m"},
+					"upperBound":     map[string]interface{}{"cpu": "1"},
+					"uncappedTarget": map[string]interface{}{"cpu": "10m"},
+				}},
+			},
+		},
+	}}
+	vpa, err := verticalPodAutoscalerV1FromUnstructured(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{map[string]interface{}{
+		"recommendation": []interface{}{map[string]interface{}{
+			"container_recommendations": []interface{}{map[string]interface{}{
+				"container_name":  "app",
+				"target":          map[string]string{"cpu": "25m", "memory": "262144k"},
+				"lower_bound":     map[string]string{"cpu": "25m"},
+				"upper_bound":     map[string]string{"cpu": "1"},
+				"uncapped_target": map[string]string{"cpu": "10m"},
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(expected, flattenVerticalPodAutoscalerV1Status(vpa.Status)); diff != "" {
+		t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+	}
+}
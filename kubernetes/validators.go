@@ -4,9 +4,13 @@
 package kubernetes
 
 import (
+	"bytes"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -15,6 +19,8 @@ import (
 	"github.com/robfig/cron"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -86,6 +92,62 @@ func validateGenerateName(value interface{}, key string) (ws []string, es []erro
 	return
 }
 
+func validateQualifiedName(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	for _, msg := range utilValidation.IsQualifiedName(v) {
+		es = append(es, fmt.Errorf("%s (%q) %s", key, v, msg))
+	}
+	return
+}
+
+func validateHostname(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	for _, msg := range utilValidation.IsDNS1123Subdomain(v) {
+		es = append(es, fmt.Errorf("%s (%q) %s", key, v, msg))
+	}
+	return
+}
+
+// validateDNS1123Label accepts a lowercase RFC 1123 label of up to 63
+// characters, such as an EndpointSlice port name.
+func validateDNS1123Label(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	for _, msg := range utilValidation.IsDNS1123Label(v) {
+		es = append(es, fmt.Errorf("%s (%q) %s", key, v, msg))
+	}
+	return
+}
+
+// validateWildcardHostname accepts a DNS subdomain, optionally prefixed with a
+// "*." wildcard label as ingress hosts allow.
+func validateWildcardHostname(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	msgs := utilValidation.IsDNS1123Subdomain(v)
+	if strings.HasPrefix(v, "*.") {
+		msgs = utilValidation.IsWildcardDNS1123Subdomain(v)
+	}
+	for _, msg := range msgs {
+		es = append(es, fmt.Errorf("%s (%q) %s", key, v, msg))
+	}
+	return
+}
+
+func validateLabelSelectorString(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := labels.Parse(v); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid label selector: %s", key, v, err))
+	}
+	return
+}
+
+func validateFieldSelectorString(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := fields.ParseSelector(v); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid field selector: %s", key, v, err))
+	}
+	return
+}
+
 func validateLabels(value interface{}, key string) (ws []string, es []error) {
 	m := value.(map[string]interface{})
 	for k, v := range m {
@@ -265,6 +327,30 @@ func validatePath(v interface{}, k string) ([]string, []error) {
 	return []string{}, []error{}
 }
 
+// validateAbsolutePath makes sure path:
+//   - is an abs path
+//   - does not contain any '..' elements
+//   - is at most 4096 characters long
+func validateAbsolutePath(v interface{}, k string) ([]string, []error) {
+	targetPath := v.(string)
+
+	if !path.IsAbs(targetPath) {
+		return []string{}, []error{fmt.Errorf("%q must be an absolute path", k)}
+	}
+
+	for _, part := range strings.Split(targetPath, "/") {
+		if part == ".." {
+			return []string{}, []error{fmt.Errorf("%q must not contain %q", k, "..")}
+		}
+	}
+
+	if len(targetPath) > 4096 {
+		return []string{}, []error{fmt.Errorf("%q must be at most 4096 characters long", k)}
+	}
+
+	return []string{}, []error{}
+}
+
 func validateTypeStringNullableIntOrPercent(v interface{}, key string) (ws []string, es []error) {
 	value, ok := v.(string)
 	if !ok {
@@ -301,3 +387,93 @@ func validateCronExpression(v interface{}, k string) ([]string, []error) {
 
 	return []string{}, errors
 }
+
+// validateCABundle accepts a PEM encoded CA bundle, which is sent to the API
+// server as is.
+func validateCABundle(v interface{}, key string) (ws []string, es []error) {
+	s, ok := v.(string)
+	if !ok {
+		es = []error{fmt.Errorf("%s: must be a string", key)}
+		return
+	}
+	if s == "" {
+		return
+	}
+	if block, _ := pem.Decode([]byte(s)); block == nil {
+		es = []error{fmt.Errorf("%s: must be a valid PEM encoded CA bundle", key)}
+	}
+	return
+}
+
+func validateCertificateRequestPEM(v interface{}, key string) (ws []string, es []error) {
+	s, ok := v.(string)
+	if !ok {
+		es = []error{fmt.Errorf("%s: must be a string", key)}
+		return
+	}
+
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		es = []error{fmt.Errorf("%s: must be a PEM encoded certificate request", key)}
+		return
+	}
+	if block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+		es = []error{fmt.Errorf("%s: expected PEM block of type %q, got %q", key, "CERTIFICATE REQUEST", block.Type)}
+	}
+	return
+}
+
+func validatePEMCertificates(v interface{}, key string) (ws []string, es []error) {
+	s, ok := v.(string)
+	if !ok {
+		es = []error{fmt.Errorf("%s: must be a string", key)}
+		return
+	}
+
+	rest := []byte(s)
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		count++
+		if block.Type != "CERTIFICATE" {
+			es = append(es, fmt.Errorf("%s: expected PEM block of type %q, got %q", key, "CERTIFICATE", block.Type))
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			es = append(es, fmt.Errorf("%s: PEM block %d is not a valid certificate: %s", key, count, err))
+		}
+	}
+	if count == 0 {
+		es = append(es, fmt.Errorf("%s: must contain at least one PEM encoded certificate", key))
+	} else if len(bytes.TrimSpace(rest)) > 0 {
+		es = append(es, fmt.Errorf("%s: contains data that is not PEM encoded", key))
+	}
+	return
+}
+
+// validateProxyURL accepts the proxy URL schemes supported by client-go.
+func validateProxyURL(v interface{}, key string) (ws []string, es []error) {
+	s := v.(string)
+	if s == "" {
+		return
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s: %s", key, err))
+		return
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		es = append(es, fmt.Errorf("%s: unsupported proxy scheme %q, must be one of http, https or socks5", key, u.Scheme))
+		return
+	}
+	if u.Host == "" {
+		es = append(es, fmt.Errorf("%s: must include a host", key))
+	}
+	return
+}
@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -135,6 +136,111 @@ func TestValidateNonNegativeInteger(t *testing.T) {
 	}
 }
 
+func TestValidateHostname(t *testing.T) {
+	validCases := []string{
+		"foo",
+		"foo.local",
+		"foo-bar.example.com",
+	}
+	for _, data := range validCases {
+		_, es := validateHostname(data, "hostnames")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"Foo",
+		"foo_bar",
+		"-foo.local",
+	}
+	for _, data := range invalidCases {
+		_, es := validateHostname(data, "hostnames")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateDNS1123Label(t *testing.T) {
+	validCases := []string{
+		"http",
+		"grpc-web-metrics",
+		"a0123456789012345678901234567890123456789012345678901234567890b",
+	}
+	for _, data := range validCases {
+		_, es := validateDNS1123Label(data, "name")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"Http",
+		"grpc_web",
+		"-http",
+		"foo.bar",
+		"a0123456789012345678901234567890123456789012345678901234567890bc",
+	}
+	for _, data := range invalidCases {
+		_, es := validateDNS1123Label(data, "name")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateWildcardHostname(t *testing.T) {
+	validCases := []string{
+		"foo.example.com",
+		"*.example.com",
+	}
+	for _, data := range validCases {
+		_, es := validateWildcardHostname(data, "hosts")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"Foo.example.com",
+		"*",
+		"foo.*.example.com",
+		"https://example.com",
+	}
+	for _, data := range invalidCases {
+		_, es := validateWildcardHostname(data, "hosts")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateAbsolutePath(t *testing.T) {
+	validCases := []string{
+		"/dev/termination-log",
+		"/tmp/termination/message",
+	}
+	for _, data := range validCases {
+		_, es := validateAbsolutePath(data, "termination_message_path")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"termination-log",
+		"/tmp/../etc/passwd",
+		"/" + strings.Repeat("a", 4096),
+	}
+	for _, data := range invalidCases {
+		_, es := validateAbsolutePath(data, "termination_message_path")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
 func TestValidateTypeStringNullableIntOrPercent(t *testing.T) {
 	validCases := []string{
 		"",
@@ -164,3 +270,231 @@ func TestValidateTypeStringNullableIntOrPercent(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCronExpression(t *testing.T) {
+	validCases := []string{
+		"* * * * *",
+		"0 0 * * *",
+		"*/15 9-17 * * 1-5",
+		"1 0 1,15 * *",
+		"0 12 * JAN,JUL MON",
+		"@yearly",
+		"@annually",
+		"@monthly",
+		"@weekly",
+		"@daily",
+		"@midnight",
+		"@hourly",
+	}
+	for _, data := range validCases {
+		_, es := validateCronExpression(data, "schedule")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"* * * *",
+		"0 * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 32 * *",
+		"@fortnightly",
+		"every minute",
+	}
+	for _, data := range invalidCases {
+		_, es := validateCronExpression(data, "schedule")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateCABundle(t *testing.T) {
+	validCases := []string{
+		"",
+		"-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END CERTIFICATE-----\n",
+	}
+	for _, data := range validCases {
+		_, es := validateCABundle(data, "ca_bundle")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"not base64",
+		"dGVzdA==",
+		"-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n",
+	}
+	for _, data := range invalidCases {
+		_, es := validateCABundle(data, "ca_bundle")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateProxyURL(t *testing.T) {
+	validCases := []string{
+		"",
+		"http://proxy.example.com:3128",
+		"https://proxy.example.com",
+		"socks5://127.0.0.1:1080",
+	}
+	for _, data := range validCases {
+		_, es := validateProxyURL(data, "proxy_url")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"proxy.example.com:3128",
+		"ftp://proxy.example.com",
+		"socks4://127.0.0.1:1080",
+		"http://",
+	}
+	for _, data := range invalidCases {
+		_, es := validateProxyURL(data, "proxy_url")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateCertificateRequestPEM(t *testing.T) {
+	validCases := []string{
+		"-----BEGIN CERTIFICATE REQUEST-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END CERTIFICATE REQUEST-----\n",
+		"-----BEGIN NEW CERTIFICATE REQUEST-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END NEW CERTIFICATE REQUEST-----\n",
+	}
+	for _, data := range validCases {
+		_, es := validateCertificateRequestPEM(data, "request")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"MIIBszCCAVmgAwIBAgIUB0ZC",
+		"-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END CERTIFICATE-----\n",
+	}
+	for _, data := range invalidCases {
+		_, es := validateCertificateRequestPEM(data, "request")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidatePEMCertificates(t *testing.T) {
+	cert := `-----BEGIN CERTIFICATE-----
+MIIBhzCCAS2gAwIBAgIUMEbpVKYrpme1K4T+oJHW0TWwAOYwCgYIKoZIzj0EAwIw
+GTEXMBUGA1UEAwwOdGYtYWNjLXRlc3QtY2EwHhcNMjYxMDE1MTEwMDE5WhcNMzYx
+MDEyMTEwMDE5WjAZMRcwFQYDVQQDDA50Zi1hY2MtdGVzdC1jYTBZMBMGByqGSM49
+AgEGCCqGSM49AwEHA0IABG3BXi8b/fEKLiq1bpmmfUmna4xmWse8r2X6Q+bszqLR
+NdKi23N2DUGVJcHlhmutg8k8R+1lbal5/AxMQJmdpSejUzBRMB0GA1UdDgQWBBR0
+TuAp3QDMM84kJMJAHd+vjhBAbzAfBgNVHSMEGDAWgBR0TuAp3QDMM84kJMJAHd+v
+jhBAbzAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCICQTZdTrtX0t
+w6PNHdUjeo5evNEpAF43uRUvS0knqo3AAiEA1zWntqhxwYC3wnvrvpjb+qJtVU3N
+xFkkETtIJTba3UI=
+-----END CERTIFICATE-----
+`
+	validCases := []string{
+		cert,
+		cert + cert,
+	}
+	for _, data := range validCases {
+		_, es := validatePEMCertificates(data, "trust_bundle")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"",
+		"not a certificate",
+		"-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END CERTIFICATE-----\n",
+		"-----BEGIN CERTIFICATE REQUEST-----\nMIIBszCCAVmgAwIBAgIUB0ZC\n-----END CERTIFICATE REQUEST-----\n",
+		cert + "trailing garbage",
+	}
+	for _, data := range invalidCases {
+		_, es := validatePEMCertificates(data, "trust_bundle")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateLabelSelectorString(t *testing.T) {
+	validCases := []string{
+		"",
+		"app=web",
+		"app in (web, api),tier!=frontend",
+		"!deprecated",
+	}
+	for _, data := range validCases {
+		_, es := validateLabelSelectorString(data, "label_selector")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"app=web,",
+		"app in web",
+		"=web",
+	}
+	for _, data := range invalidCases {
+		_, es := validateLabelSelectorString(data, "label_selector")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateFieldSelectorString(t *testing.T) {
+	validCases := []string{
+		"",
+		"metadata.name=default",
+		"metadata.name!=default,status.phase=Active",
+	}
+	for _, data := range validCases {
+		_, es := validateFieldSelectorString(data, "field_selector")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []string{
+		"metadata.name",
+		"metadata.name in (default)",
+	}
+	for _, data := range invalidCases {
+		_, es := validateFieldSelectorString(data, "field_selector")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", data)
+		}
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	validCases := []map[string]interface{}{
+		{},
+		{"example.com/owner": "team-a"},
+		{"nginx.ingress.kubernetes.io/rewrite-target": "/$2"},
+		{"ad.datadoghq.com/nginx.check_names": `["nginx"]`},
+	}
+	for _, data := range validCases {
+		_, es := validateAnnotations(data, "annotations")
+		if len(es) > 0 {
+			t.Fatalf("Expected %v to be valid: %#v", data, es)
+		}
+	}
+	invalidCases := []map[string]interface{}{
+		{"-invalid/key": "value"},
+		{"example.com/": "value"},
+		{"has space": "value"},
+	}
+	for _, data := range invalidCases {
+		_, es := validateAnnotations(data, "annotations")
+		if len(es) == 0 {
+			t.Fatalf("Expected %v to be invalid", data)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseJSONPointer splits a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+// The empty pointer, which refers to the whole document, is not accepted.
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer %q must start with a '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j == len(t)-1 || (t[j+1] != '0' && t[j+1] != '1')) {
+				return nil, fmt.Errorf("JSON pointer %q contains an invalid escape sequence", pointer)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// RemoveJSONPointer removes the value referenced by the JSON Pointer from an
+// unstructured object, as decoded from JSON. Array elements are referenced by
+// their index. It reports whether a value was found and removed.
+func RemoveJSONPointer(in map[string]interface{}, pointer string) (bool, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return false, err
+	}
+	return RemoveJSONPointerTokens(in, tokens), nil
+}
+
+// RemoveJSONPointerTokens is like RemoveJSONPointer, for a pointer that has
+// already been parsed into its reference tokens.
+func RemoveJSONPointerTokens(in map[string]interface{}, tokens []string) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	_, removed := removeTokens(in, tokens)
+	return removed
+}
+
+// removeTokens returns the value with the element at the token path removed.
+// Maps are updated in place, while slices are copied when an element is removed
+// so the caller has to store the returned value.
+func removeTokens(in interface{}, tokens []string) (interface{}, bool) {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		child, ok := v[tokens[0]]
+		if !ok {
+			return v, false
+		}
+		if len(tokens) == 1 {
+			delete(v, tokens[0])
+			return v, true
+		}
+		nc, removed := removeTokens(child, tokens[1:])
+		v[tokens[0]] = nc
+		return v, removed
+	case []interface{}:
+		i, err := strconv.Atoi(tokens[0])
+		if err != nil || i < 0 || i >= len(v) {
+			return v, false
+		}
+		if len(tokens) == 1 {
+			out := make([]interface{}, 0, len(v)-1)
+			out = append(out, v[:i]...)
+			return append(out, v[i+1:]...), true
+		}
+		nc, removed := removeTokens(v[i], tokens[1:])
+		v[i] = nc
+		return v, removed
+	}
+	return in, false
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseJSONPointer(t *testing.T) {
+	samples := map[string]struct {
+		In      string
+		Out     []string
+		WantErr bool
+	}{
+		"single token": {
+			In:  "/status",
+			Out: []string{"status"},
+		},
+		"nested tokens": {
+			In:  "/spec/template/spec",
+			Out: []string{"spec", "template", "spec"},
+		},
+		"escaped slash": {
+			In:  "/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration",
+			Out: []string{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+		},
+		"escaped tilde": {
+			In:  "/metadata/labels/a~0b~01",
+			Out: []string{"metadata", "labels", "a~b~1"},
+		},
+		"empty token": {
+			In:  "/data/",
+			Out: []string{"data", ""},
+		},
+		"missing leading slash": {
+			In:      "spec/replicas",
+			WantErr: true,
+		},
+		"whole document": {
+			In:      "",
+			WantErr: true,
+		},
+		"invalid escape": {
+			In:      "/metadata/labels/a~2b",
+			WantErr: true,
+		},
+		"trailing tilde": {
+			In:      "/metadata/labels/a~",
+			WantErr: true,
+		},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			out, err := ParseJSONPointer(s.In)
+			if s.WantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %q", s.In)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(out, s.Out) {
+				t.Fatalf("unexpected tokens: %s", cmp.Diff(s.Out, out))
+			}
+		})
+	}
+}
+
+func TestRemoveJSONPointer(t *testing.T) {
+	newObject := func() map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "test",
+				"annotations": map[string]interface{}{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					"example.com/owner": "team-a",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": 3,
+				"containers": []interface{}{
+					map[string]interface{}{"name": "app", "image": "app:1", "env": []interface{}{"A", "B"}},
+					map[string]interface{}{"name": "sidecar", "image": "sidecar:1"},
+				},
+			},
+		}
+	}
+	samples := map[string]struct {
+		Pointer string
+		Removed bool
+		Out     func() map[string]interface{}
+	}{
+		"top level field": {
+			Pointer: "/spec",
+			Removed: true,
+			Out: func() map[string]interface{} {
+				o := newObject()
+				delete(o, "spec")
+				return o
+			},
+		},
+		"nested map key with escaped slash": {
+			Pointer: "/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration",
+			Removed: true,
+			Out: func() map[string]interface{} {
+				o := newObject()
+				delete(o["metadata"].(map[string]interface{})["annotations"].(map[string]interface{}), "kubectl.kubernetes.io/last-applied-configuration")
+				return o
+			},
+		},
+		"field in array element": {
+			Pointer: "/spec/containers/1/image",
+			Removed: true,
+			Out: func() map[string]interface{} {
+				o := newObject()
+				delete(o["spec"].(map[string]interface{})["containers"].([]interface{})[1].(map[string]interface{}), "image")
+				return o
+			},
+		},
+		"array element": {
+			Pointer: "/spec/containers/0",
+			Removed: true,
+			Out: func() map[string]interface{} {
+				o := newObject()
+				spec := o["spec"].(map[string]interface{})
+				spec["containers"] = spec["containers"].([]interface{})[1:]
+				return o
+			},
+		},
+		"element of nested array": {
+			Pointer: "/spec/containers/0/env/0",
+			Removed: true,
+			Out: func() map[string]interface{} {
+				o := newObject()
+				c := o["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+				c["env"] = []interface{}{"B"}
+				return o
+			},
+		},
+		"missing field": {
+			Pointer: "/spec/template/spec",
+			Out:     newObject,
+		},
+		"array index out of range": {
+			Pointer: "/spec/containers/2/image",
+			Out:     newObject,
+		},
+		"non numeric array index": {
+			Pointer: "/spec/containers/app",
+			Out:     newObject,
+		},
+		"path through scalar": {
+			Pointer: "/spec/replicas/value",
+			Out:     newObject,
+		},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			obj := newObject()
+			removed, err := RemoveJSONPointer(obj, s.Pointer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if removed != s.Removed {
+				t.Fatalf("expected removed to be %t, got %t", s.Removed, removed)
+			}
+			if !cmp.Equal(obj, s.Out()) {
+				t.Fatalf("unexpected object: %s", cmp.Diff(s.Out(), obj))
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -132,6 +133,17 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		computedFields[atp.String()] = atp
 	}
 
+	ignoreFields, err := getIgnoreFields(plannedStateVal)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid ignore_fields configuration",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields"),
+		})
+		return resp, nil
+	}
+
 	c, err := s.getDynamicClient()
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics,
@@ -285,6 +297,11 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		// remove null attributes - the API doesn't appreciate requests that include them
 		rqObj := mapRemoveNulls(pu.(map[string]interface{}))
 
+		// ignored fields are left to the other managers of the resource
+		for _, tokens := range ignoreFields {
+			manifest.RemoveJSONPointerTokens(rqObj, tokens)
+		}
+
 		uo := unstructured.Unstructured{}
 		uo.SetUnstructuredContent(rqObj)
 		rnamespace := uo.GetNamespace()
@@ -357,6 +374,35 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			return resp, nil
 		}
 
+		// validate the manifest now if it had unknown values during planning
+		if dr, ok := plannedStateVal["dry_run_result"]; ok && !dr.IsKnown() {
+			s.logger.Trace("[ApplyResourceChange][DryRun]", "[API Payload]", jsonManifest)
+			dryRunResult, err := rs.Patch(ctx, rname, types.ApplyPatchType, jsonManifest,
+				metav1.PatchOptions{
+					FieldManager: fieldManagerName,
+					Force:        &forceConflicts,
+					DryRun:       []string{metav1.DryRunAll},
+				},
+			)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Dry-run failed",
+					Detail:   fmt.Sprintf("A dry-run apply was performed for resource %q but was rejected by the API server: %v", rnn, err),
+				})
+				return resp, nil
+			}
+			plannedStateVal["dry_run_result"], err = dryRunResultValue(dryRunResult)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Failed to encode dry-run result",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+		}
+
 		// figure out the timeout deadline
 		timeouts := s.getTimeouts(plannedStateVal)
 		var timeout time.Duration
@@ -472,7 +518,10 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		if err != nil {
 			return resp, err
 		}
-		plannedStateVal["object"] = morph.UnknownToNull(compObj)
+		plannedStateVal["object"], err = NullIgnoredFields(morph.UnknownToNull(compObj), ignoreFields)
+		if err != nil {
+			return resp, err
+		}
 
 		newStateVal := tftypes.NewValue(applyPlannedState.Type(), plannedStateVal)
 		s.logger.Trace("[ApplyResourceChange][Apply]", "new state value", dump(newStateVal))
@@ -590,6 +590,94 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		}
 	}
 
+	if !providerConfig["oidc"].IsNull() && providerConfig["oidc"].IsKnown() {
+		var oidcBlock []tftypes.Value
+		err = providerConfig["oidc"].As(&oidcBlock)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'oidc' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+		if len(oidcBlock) > 0 {
+			var oidcObj map[string]tftypes.Value
+			err := oidcBlock[0].As(&oidcObj)
+			if err != nil {
+				response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  `Provider configuration: failed to assert type of "oidc" block`,
+					Detail:   err.Error(),
+				})
+				return response, nil
+			}
+			oidcCfg := make(map[string]string)
+			for attr, key := range map[string]string{
+				"idp_issuer_url":                 "idp-issuer-url",
+				"client_id":                      "client-id",
+				"client_secret":                  "client-secret",
+				"id_token":                       "id-token",
+				"refresh_token":                  "refresh-token",
+				"idp_certificate_authority_data": "idp-certificate-authority-data",
+			} {
+				if oidcObj[attr].IsNull() || !oidcObj[attr].IsKnown() {
+					continue
+				}
+				var v string
+				err = oidcObj[attr].As(&v)
+				if err != nil {
+					// invalid attribute type - this shouldn't happen, bail out for now
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Provider configuration: failed to assert type of '%s' value", attr),
+						Detail:   err.Error(),
+					})
+					return response, nil
+				}
+				if v != "" {
+					oidcCfg[key] = v
+				}
+			}
+			if !oidcObj["extra_scopes"].IsNull() && oidcObj["extra_scopes"].IsFullyKnown() {
+				var xScopes []tftypes.Value
+				err = oidcObj["extra_scopes"].As(&xScopes)
+				if err != nil {
+					// invalid attribute type - this shouldn't happen, bail out for now
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider configuration: failed to assert type of 'extra_scopes' value",
+						Detail:   err.Error(),
+					})
+					return response, nil
+				}
+				scopes := make([]string, 0, len(xScopes))
+				for _, scope := range xScopes {
+					var v string
+					err := scope.As(&v)
+					if err != nil {
+						// invalid attribute type - this shouldn't happen, bail out for now
+						response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+							Severity: tfprotov5.DiagnosticSeverityError,
+							Summary:  "Provider configuration: failed to assert type of element in 'extra_scopes' value",
+							Detail:   err.Error(),
+						})
+						return response, nil
+					}
+					scopes = append(scopes, v)
+				}
+				if len(scopes) > 0 {
+					oidcCfg["extra-scopes"] = strings.Join(scopes, ",")
+				}
+			}
+			overrides.AuthInfo.AuthProvider = &clientcmdapi.AuthProviderConfig{
+				Name:   "oidc",
+				Config: oidcCfg,
+			}
+		}
+	}
+
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
 	clientConfig, err := cc.ClientConfig()
 	if err != nil {
@@ -606,6 +694,12 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		return response, nil
 	}
 
+	if overrides.AuthInfo.AuthProvider != nil {
+		// Refreshed OIDC tokens are only kept in memory, they must never be
+		// written back to a kubeconfig file.
+		clientConfig.AuthConfigPersister = inMemoryAuthConfigPersister{}
+	}
+
 	if s.logger.IsTrace() {
 		clientConfig.WrapTransport = loggingTransport
 	}
@@ -629,3 +723,12 @@ func (s *RawProviderServer) canExecute() (resp []*tfprotov5.Diagnostic) {
 	}
 	return
 }
+
+// inMemoryAuthConfigPersister discards the auth provider configuration the
+// OIDC plugin persists after refreshing a token. The plugin keeps the
+// refreshed token in its own in-memory cache.
+type inMemoryAuthConfigPersister struct{}
+
+func (inMemoryAuthConfigPersister) Persist(map[string]string) error {
+	return nil
+}
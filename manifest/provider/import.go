@@ -165,6 +165,10 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	timeoutsType := rt.(tftypes.Object).AttributeTypes["timeouts"]
 	fmType := rt.(tftypes.Object).AttributeTypes["field_manager"]
 	cmpType := rt.(tftypes.Object).AttributeTypes["computed_fields"]
+	ignType := rt.(tftypes.Object).AttributeTypes["ignore_fields"]
+	dryRunType := rt.(tftypes.Object).AttributeTypes["dry_run"]
+	dryRunResultType := rt.(tftypes.Object).AttributeTypes["dry_run_result"]
+	validateType := rt.(tftypes.Object).AttributeTypes["validate_manifest"]
 
 	newState["manifest"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, nil)
 	newState["object"] = morph.UnknownToNull(nobj)
@@ -173,6 +177,10 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	newState["timeouts"] = tftypes.NewValue(timeoutsType, nil)
 	newState["field_manager"] = tftypes.NewValue(fmType, nil)
 	newState["computed_fields"] = tftypes.NewValue(cmpType, nil)
+	newState["ignore_fields"] = tftypes.NewValue(ignType, nil)
+	newState["dry_run"] = tftypes.NewValue(dryRunType, nil)
+	newState["dry_run_result"] = tftypes.NewValue(dryRunResultType, nil)
+	newState["validate_manifest"] = tftypes.NewValue(validateType, nil)
 
 	nsVal := tftypes.NewValue(rt, newState)
 
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -15,24 +16,25 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 )
 
-func (s *RawProviderServer) dryRun(ctx context.Context, obj tftypes.Value, fieldManager string, forceConflicts bool, isNamespaced bool) error {
+func (s *RawProviderServer) dryRun(ctx context.Context, obj tftypes.Value, fieldManager string, forceConflicts bool, isNamespaced bool) (*unstructured.Unstructured, error) {
 	c, err := s.getDynamicClient()
 	if err != nil {
-		return fmt.Errorf("failed to retrieve Kubernetes dynamic client during apply: %v", err)
+		return nil, fmt.Errorf("failed to retrieve Kubernetes dynamic client during apply: %v", err)
 	}
 	m, err := s.getRestMapper()
 	if err != nil {
-		return fmt.Errorf("failed to retrieve Kubernetes RESTMapper client during apply: %v", err)
+		return nil, fmt.Errorf("failed to retrieve Kubernetes RESTMapper client during apply: %v", err)
 	}
 
 	minObj := morph.UnknownToNull(obj)
 	pu, err := payload.FromTFValue(minObj, nil, tftypes.NewAttributePath())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rqObj := mapRemoveNulls(pu.(map[string]interface{}))
@@ -44,7 +46,7 @@ func (s *RawProviderServer) dryRun(ctx context.Context, obj tftypes.Value, field
 
 	gvr, err := GVRFromUnstructured(&uo, m)
 	if err != nil {
-		return fmt.Errorf("failed to determine resource GVR: %s", err)
+		return nil, fmt.Errorf("failed to determine resource GVR: %s", err)
 	}
 
 	var rs dynamic.ResourceInterface
@@ -56,17 +58,162 @@ func (s *RawProviderServer) dryRun(ctx context.Context, obj tftypes.Value, field
 
 	jsonManifest, err := uo.MarshalJSON()
 	if err != nil {
-		return fmt.Errorf("failed to marshall resource %q to JSON: %v", rnn, err)
+		return nil, fmt.Errorf("failed to marshall resource %q to JSON: %v", rnn, err)
 	}
-	_, err = rs.Patch(ctx, rname, types.ApplyPatchType, jsonManifest,
+	return rs.Patch(ctx, rname, types.ApplyPatchType, jsonManifest,
 		metav1.PatchOptions{
 			FieldManager: fieldManager,
 			Force:        &forceConflicts,
-			DryRun:       []string{"All"},
+			DryRun:       []string{metav1.DryRunAll},
 		},
 	)
+}
+
+// dryRunResultValue encodes the object returned by a dry-run apply as the value
+// of the "dry_run_result" attribute. Fields that change with every request are
+// removed so that repeated dry-runs of the same manifest produce the same value.
+func dryRunResultValue(u *unstructured.Unstructured) (tftypes.Value, error) {
+	js, err := json.Marshal(RemoveServerSideFields(u.DeepCopy().Object))
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tftypes.NewValue(tftypes.String, string(js)), nil
+}
+
+func isDryRunEnabled(v map[string]tftypes.Value) bool {
+	var enabled bool
+	if dr, ok := v["dry_run"]; ok && !dr.IsNull() && dr.IsKnown() {
+		dr.As(&enabled)
+	}
+	return enabled
+}
+
+// planDryRunResult validates the planned manifest with a dry-run apply when "dry_run"
+// is enabled and returns the value to plan for the "dry_run_result" attribute.
+func (s *RawProviderServer) planDryRunResult(ctx context.Context, proposedVal map[string]tftypes.Value, priorVal map[string]tftypes.Value, isNamespaced bool) (tftypes.Value, []*tfprotov5.Diagnostic) {
+	if !isDryRunEnabled(proposedVal) {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+	ppMan := proposedVal["manifest"]
+	if !ppMan.IsFullyKnown() {
+		// the dry-run is deferred to apply, once all the values in the manifest are known
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+	if prior, ok := priorVal["dry_run_result"]; ok && !prior.IsNull() && prior.IsKnown() && priorVal["manifest"].Equal(ppMan) {
+		// the manifest has not changed since it was last validated
+		return prior, nil
+	}
+
+	fieldManagerName, forceConflicts, err := s.getFieldManagerConfig(proposedVal)
+	if err != nil {
+		return tftypes.Value{}, []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Could not extract field_manager config",
+			Detail:   err.Error(),
+		}}
+	}
+	res, err := s.dryRun(ctx, ppMan, fieldManagerName, forceConflicts, isNamespaced)
+	if err != nil {
+		return tftypes.Value{}, []*tfprotov5.Diagnostic{{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Dry-run failed",
+			Detail:    fmt.Sprintf("A dry-run apply was performed for this resource but was rejected by the API server: %v", err),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("manifest"),
+		}}
+	}
+	result, err := dryRunResultValue(res)
+	if err != nil {
+		return tftypes.Value{}, []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to encode dry-run result",
+			Detail:   err.Error(),
+		}}
+	}
+	return result, nil
+}
+
+func isManifestValidationEnabled(v map[string]tftypes.Value) bool {
+	var enabled bool
+	if vm, ok := v["validate_manifest"]; ok && !vm.IsNull() && vm.IsKnown() {
+		vm.As(&enabled)
+	}
+	return enabled
+}
+
+// planValidateManifest validates the planned manifest against the schema of the
+// CRD serving gvk when "validate_manifest" is enabled. Manifests of built-in
+// resources, and manifests with values that are not known yet, are not validated.
+// Terraform plans again during apply, once all values are known.
+func (s *RawProviderServer) planValidateManifest(ctx context.Context, proposedVal map[string]tftypes.Value, gvk schema.GroupVersionKind) []*tfprotov5.Diagnostic {
+	if !isManifestValidationEnabled(proposedVal) {
+		return nil
+	}
+	ppMan := proposedVal["manifest"]
+	if !ppMan.IsFullyKnown() {
+		return nil
+	}
+	crdSchema, err := s.lookUpValidationSchema(ctx, gvk)
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to look up CRD schema",
+			Detail:   fmt.Sprintf("failed to look up GVK [%s] among available CRDs: %s", gvk.String(), err),
+		}}
+	}
+	cs, ok := crdSchema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	pu, err := payload.FromTFValue(ppMan, nil, tftypes.NewAttributePath())
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to convert manifest for validation",
+			Detail:   err.Error(),
+		}}
+	}
+	errs, err := ValidateAgainstCRDSchema(cs, mapRemoveNulls(pu.(map[string]interface{})))
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to validate manifest against CRD schema",
+			Detail:   err.Error(),
+		}}
+	}
+	diags := make([]*tfprotov5.Diagnostic, 0, len(errs))
+	for _, e := range errs {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Manifest does not match the CRD schema",
+			Detail:    fmt.Sprintf("The manifest of this %s was rejected by the schema of its CustomResourceDefinition: %s", gvk.Kind, e.Error()),
+			Attribute: manifestAttributePath(e.Field),
+		})
+	}
+	return diags
+}
+
+// lookUpValidationSchema returns the CRD schema to validate manifests of gvk
+// against. Schemas that are found are cached for the lifetime of the provider
+// instance, so that manifests of the same kind do not each list every CRD.
+// Misses are not cached, as the CRD may still be created by another resource
+// during the same run. Only plan validation uses the cache: the types of
+// manifests always come from the current schema.
+func (s *RawProviderServer) lookUpValidationSchema(ctx context.Context, gvk schema.GroupVersionKind) (interface{}, error) {
+	s.crdSchemasLock.Lock()
+	defer s.crdSchemasLock.Unlock()
 
-	return err
+	if cs, ok := s.crdSchemas[gvk]; ok {
+		return cs, nil
+	}
+	cs, err := s.lookUpGVKinCRDs(ctx, gvk)
+	if err != nil || cs == nil {
+		return cs, err
+	}
+	if s.crdSchemas == nil {
+		s.crdSchemas = make(map[schema.GroupVersionKind]interface{})
+	}
+	s.crdSchemas[gvk] = cs
+	return cs, nil
 }
 
 const defaultFieldManagerName = "Terraform"
@@ -360,7 +507,7 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 			return resp, nil
 		}
 
-		err = s.dryRun(ctx, ppMan, fieldManagerName, forceConflicts, ns)
+		_, err = s.dryRun(ctx, ppMan, fieldManagerName, forceConflicts, ns)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
@@ -376,6 +523,18 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		}
 	}
 
+	if d := s.planValidateManifest(ctx, proposedVal, gvk); len(d) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, d...)
+		return resp, nil
+	}
+
+	dryRunResult, d := s.planDryRunResult(ctx, proposedVal, priorVal, ns)
+	if len(d) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, d...)
+		return resp, nil
+	}
+	proposedVal["dry_run_result"] = dryRunResult
+
 	so := objectType.(tftypes.Object)
 	s.logger.Debug("[PlanUpdateResource]", "OAPI type", dump(so))
 
@@ -514,6 +673,27 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		proposedVal["object"] = updatedObj
 	}
 
+	ignoreFields, err := getIgnoreFields(proposedVal)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid ignore_fields configuration",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields"),
+		})
+		return resp, nil
+	}
+	proposedVal["object"], err = NullIgnoredFields(proposedVal["object"], ignoreFields)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to remove ignored fields from planned state",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("object"),
+		})
+		return resp, nil
+	}
+
 	propStateVal := tftypes.NewValue(proposedState.Type(), proposedVal)
 	s.logger.Trace("[PlanResourceChange]", "new planned state", dump(propStateVal))
 
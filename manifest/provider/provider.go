@@ -210,6 +210,30 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 						Description: "List of manifest fields whose values can be altered by the API server during 'apply'. Defaults to: [\"metadata.annotations\", \"metadata.labels\"]",
 						Optional:    true,
 					},
+					{
+						Name:        "ignore_fields",
+						Type:        tftypes.List{ElementType: tftypes.String},
+						Description: "List of JSON pointers (RFC 6901) to fields of the resource that are managed outside of Terraform, e.g. `/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration`. These fields are neither applied nor tracked in `object`, so changes made to them by the API server or other controllers do not cause a diff.",
+						Optional:    true,
+					},
+					{
+						Name:        "dry_run",
+						Type:        tftypes.Bool,
+						Description: "When set to true, the manifest is validated by the API server with a dry-run apply during planning, so that invalid manifests are reported before any change is made.",
+						Optional:    true,
+					},
+					{
+						Name:        "validate_manifest",
+						Type:        tftypes.Bool,
+						Description: "When set to true, manifests of custom resources are validated during planning against the OpenAPI schema of their CustomResourceDefinition, so that invalid fields are reported before any change is made.",
+						Optional:    true,
+					},
+					{
+						Name:        "dry_run_result",
+						Type:        tftypes.String,
+						Description: "The object returned by the API server for the last dry-run apply, encoded as JSON. Only set when `dry_run` is enabled.",
+						Computed:    true,
+					},
 				},
 			},
 		},
@@ -200,6 +200,17 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				DescriptionKind: 0,
 				Deprecated:      false,
 			},
+			{
+				Name:            "validate_referenced_secrets",
+				Type:            tftypes.Bool,
+				Description:     "Check at plan time that secrets referenced by resources exist, e.g. the TLS secrets of `kubernetes_ingress_v1`. Requires permission to read those secrets. Secrets created later, e.g. in the same apply or by cert-manager, fail the check.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
 		},
 		BlockTypes: []*tfprotov5.SchemaNestedBlock{
 			{
@@ -208,6 +219,7 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				MinItems: 0,
 				MaxItems: 1,
 				Block: &tfprotov5.SchemaBlock{
+					Description: "Configuration block to use an exec-based credential plugin, e.g. call an external command to receive user credentials.",
 					Attributes: []*tfprotov5.SchemaAttribute{
 						{
 							Name:            "api_version",
@@ -216,6 +228,7 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							Optional:        false,
 							Computed:        false,
 							Sensitive:       false,
+							Description:     "API version to use when decoding the ExecCredentials resource, e.g. `client.authentication.k8s.io/v1beta1`.",
 							DescriptionKind: 0,
 							Deprecated:      false,
 						},
@@ -226,6 +239,7 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							Optional:        false,
 							Computed:        false,
 							Sensitive:       false,
+							Description:     "Command to execute.",
 							DescriptionKind: 0,
 							Deprecated:      false,
 						},
@@ -236,6 +250,7 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							Optional:        true,
 							Computed:        false,
 							Sensitive:       false,
+							Description:     "Map of environment variables to set when executing the plugin.",
 							DescriptionKind: 0,
 							Deprecated:      false,
 						},
@@ -246,6 +261,95 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							Optional:        true,
 							Computed:        false,
 							Sensitive:       false,
+							Description:     "List of arguments to pass when executing the plugin.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+					},
+				},
+			},
+			{
+				TypeName: "oidc",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				MinItems: 0,
+				MaxItems: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Description: "Configuration block to authenticate with OpenID Connect tokens. The ID token is cached in memory and refreshed using the refresh token when it expires.",
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "idp_issuer_url",
+							Type:            tftypes.String,
+							Required:        true,
+							Optional:        false,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "URL of the OpenID Connect identity provider issuing the tokens.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "client_id",
+							Type:            tftypes.String,
+							Required:        true,
+							Optional:        false,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Client ID registered with the identity provider.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "client_secret",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       true,
+							Description:     "Client secret registered with the identity provider.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "id_token",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       true,
+							Description:     "Initial ID token. If it is missing or expired, a new one is requested using the refresh token.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "refresh_token",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       true,
+							Description:     "Refresh token used to request new ID tokens from the identity provider.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "idp_certificate_authority_data",
+							Type:            tftypes.String,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Base64 encoded PEM certificate bundle used to verify the identity provider's TLS certificate.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "extra_scopes",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Additional scopes to request from the identity provider, besides `openid`.",
 							DescriptionKind: 0,
 							Deprecated:      false,
 						},
@@ -271,6 +375,17 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							DescriptionKind: 0,
 							Deprecated:      true,
 						},
+						{
+							Name:            "dynamic_resource_allocation",
+							Type:            tftypes.Bool,
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							Description:     "Enable the `resource_claim` blocks of pod specs and the `claims` blocks of container resources. These use the Dynamic Resource Allocation API, which is not stable in Kubernetes yet.",
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
 					},
 				},
 			},
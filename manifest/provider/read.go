@@ -186,7 +186,14 @@ func (s *RawProviderServer) ReadResource(ctx context.Context, req *tfprotov5.Rea
 	if err != nil {
 		return resp, err
 	}
-	rawState["object"] = morph.UnknownToNull(nobj)
+	ignoreFields, err := getIgnoreFields(rawState)
+	if err != nil {
+		return resp, err
+	}
+	rawState["object"], err = NullIgnoredFields(morph.UnknownToNull(nobj), ignoreFields)
+	if err != nil {
+		return resp, err
+	}
 
 	nsVal := tftypes.NewValue(currentState.Type(), rawState)
 	newState, err := tfprotov5.NewDynamicValue(nsVal.Type(), nsVal)
@@ -8,13 +8,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // GVRFromUnstructured extracts a canonical schema.GroupVersionResource out of the resource's
@@ -265,6 +272,44 @@ func (ps *RawProviderServer) lookUpGVKinCRDs(ctx context.Context, gvk schema.Gro
 	return nil, nil
 }
 
+// ValidateAgainstCRDSchema validates obj, a resource in its unstructured form,
+// against the OpenAPI v3 schema of the CRD serving it, as the API server would,
+// and returns the violations found.
+func ValidateAgainstCRDSchema(crdSchema map[string]interface{}, obj map[string]interface{}) (field.ErrorList, error) {
+	js, err := json.Marshal(crdSchema)
+	if err != nil {
+		return nil, fmt.Errorf("CRD schema fails to marshal into JSON: %s", err)
+	}
+	var v1Props apiextensionsv1.JSONSchemaProps
+	if err := json.Unmarshal(js, &v1Props); err != nil {
+		return nil, fmt.Errorf("CRD schema is not a valid JSON schema: %s", err)
+	}
+	var props apiextensions.JSONSchemaProps
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(&v1Props, &props, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert CRD schema: %s", err)
+	}
+	validator, _, err := validation.NewSchemaValidator(&props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validator for CRD schema: %s", err)
+	}
+	return validation.ValidateCustomResource(nil, obj, validator), nil
+}
+
+// manifestAttributePath returns the path of the manifest attribute that a field
+// path reported by the API machinery, e.g. "spec.ports[0].port", refers to. Field
+// paths that cannot be represented are reported on the manifest as a whole.
+func manifestAttributePath(fieldPath string) *tftypes.AttributePath {
+	root := tftypes.NewAttributePath().WithAttributeName("manifest")
+	if fieldPath == "" || fieldPath == "<nil>" {
+		return root
+	}
+	p, err := FieldPathToTftypesPath(fieldPath)
+	if err != nil {
+		return root
+	}
+	return tftypes.NewAttributePathWithSteps(append(root.Steps(), p.Steps()...))
+}
+
 // privateStateSchema describes the structure of the private state payload that
 // Terraform can store along with the "regular" resource state state.
 var privateStateSchema tftypes.Object = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
@@ -283,3 +328,95 @@ func getPrivateStateValue(p []byte) (ps map[string]tftypes.Value, err error) {
 	err = pv.As(&ps)
 	return
 }
+
+// getIgnoreFields parses the JSON pointers listed in the "ignore_fields" attribute
+// into their reference tokens.
+func getIgnoreFields(v map[string]tftypes.Value) ([][]string, error) {
+	ifv, ok := v["ignore_fields"]
+	if !ok || ifv.IsNull() || !ifv.IsKnown() {
+		return nil, nil
+	}
+	var elems []tftypes.Value
+	err := ifv.As(&elems)
+	if err != nil {
+		return nil, err
+	}
+	ignored := make([][]string, 0, len(elems))
+	for _, e := range elems {
+		var p string
+		if err := e.As(&p); err != nil {
+			return nil, err
+		}
+		tokens, err := manifest.ParseJSONPointer(p)
+		if err != nil {
+			return nil, err
+		}
+		ignored = append(ignored, tokens)
+	}
+	return ignored, nil
+}
+
+// attributePathTokens returns the JSON pointer reference tokens equivalent to
+// the attribute path. Set elements cannot be addressed by a JSON pointer, so
+// nil is returned for paths that traverse a set.
+func attributePathTokens(ap *tftypes.AttributePath) []string {
+	steps := ap.Steps()
+	tokens := make([]string, 0, len(steps))
+	for _, s := range steps {
+		switch v := s.(type) {
+		case tftypes.AttributeName:
+			tokens = append(tokens, string(v))
+		case tftypes.ElementKeyString:
+			tokens = append(tokens, string(v))
+		case tftypes.ElementKeyInt:
+			tokens = append(tokens, strconv.FormatInt(int64(v), 10))
+		default:
+			return nil
+		}
+	}
+	return tokens
+}
+
+func isIgnoredPath(ap *tftypes.AttributePath, ignored [][]string) bool {
+	if len(ignored) == 0 {
+		return false
+	}
+	tokens := attributePathTokens(ap)
+	if tokens == nil {
+		return false
+	}
+	for _, i := range ignored {
+		if slices.Equal(tokens, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// NullIgnoredFields sets the values found at the ignored paths of a resource
+// object to null, so that they are not part of the difference between the
+// desired and the observed state. Ignored map keys are removed from the map
+// instead, as the set of keys is part of the value of a map.
+func NullIgnoredFields(obj tftypes.Value, ignored [][]string) (tftypes.Value, error) {
+	if len(ignored) == 0 {
+		return obj, nil
+	}
+	return tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if isIgnoredPath(ap, ignored) {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		if v.Type().Is(tftypes.Map{}) && v.IsKnown() && !v.IsNull() {
+			var elems map[string]tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return v, err
+			}
+			for k := range elems {
+				if isIgnoredPath(ap.WithElementKeyString(k), ignored) {
+					delete(elems, k)
+				}
+			}
+			return tftypes.NewValue(v.Type(), elems), nil
+		}
+		return v, nil
+	})
+}
@@ -6,7 +6,10 @@ package provider
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestRemoveNulls(t *testing.T) {
@@ -69,3 +72,154 @@ func TestRemoveNulls(t *testing.T) {
 		})
 	}
 }
+
+func TestNullIgnoredFields(t *testing.T) {
+	containerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":  tftypes.String,
+		"image": tftypes.String,
+	}}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"metadata": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"annotations": tftypes.Map{ElementType: tftypes.String},
+		}},
+		"spec": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"replicas":   tftypes.Number,
+			"containers": tftypes.List{ElementType: containerType},
+		}},
+	}}
+	newObject := func(annotations map[string]tftypes.Value, replicas interface{}, image interface{}) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"metadata": tftypes.NewValue(objType.AttributeTypes["metadata"], map[string]tftypes.Value{
+				"annotations": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, annotations),
+			}),
+			"spec": tftypes.NewValue(objType.AttributeTypes["spec"], map[string]tftypes.Value{
+				"replicas": tftypes.NewValue(tftypes.Number, replicas),
+				"containers": tftypes.NewValue(tftypes.List{ElementType: containerType}, []tftypes.Value{
+					tftypes.NewValue(containerType, map[string]tftypes.Value{
+						"name":  tftypes.NewValue(tftypes.String, "app"),
+						"image": tftypes.NewValue(tftypes.String, image),
+					}),
+				}),
+			}),
+		})
+	}
+	annotations := map[string]tftypes.Value{
+		"kubectl.kubernetes.io/last-applied-configuration": tftypes.NewValue(tftypes.String, "{}"),
+		"example.com/owner": tftypes.NewValue(tftypes.String, "team-a"),
+	}
+	samples := map[string]struct {
+		ignored [][]string
+		out     tftypes.Value
+	}{
+		"nothing ignored": {
+			out: newObject(annotations, 3, "app:1"),
+		},
+		"nested field": {
+			ignored: [][]string{{"spec", "replicas"}},
+			out:     newObject(annotations, nil, "app:1"),
+		},
+		"map key": {
+			ignored: [][]string{{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"}},
+			out: newObject(map[string]tftypes.Value{
+				"example.com/owner": tftypes.NewValue(tftypes.String, "team-a"),
+			}, 3, "app:1"),
+		},
+		"field in list element": {
+			ignored: [][]string{{"spec", "containers", "0", "image"}, {"spec", "unknown"}},
+			out:     newObject(annotations, 3, nil),
+		},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			out, err := NullIgnoredFields(newObject(annotations, 3, "app:1"), s.ignored)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !out.Equal(s.out) {
+				t.Fatalf("unexpected result:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestValidateAgainstCRDSchema(t *testing.T) {
+	crdSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"size"},
+				"properties": map[string]interface{}{
+					"size": map[string]interface{}{
+						"type":    "integer",
+						"minimum": 1,
+					},
+					"mode": map[string]interface{}{
+						"type": "string",
+						"enum": []interface{}{"fast", "safe"},
+					},
+				},
+			},
+		},
+	}
+	samples := map[string]struct {
+		spec   map[string]interface{}
+		errors []string
+	}{
+		"valid": {
+			spec: map[string]interface{}{"size": int64(3), "mode": "safe"},
+		},
+		"missing required field": {
+			spec:   map[string]interface{}{"mode": "safe"},
+			errors: []string{"spec.size"},
+		},
+		"wrong type and enum value": {
+			spec:   map[string]interface{}{"size": "three", "mode": "slow"},
+			errors: []string{"spec.mode", "spec.size"},
+		},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			obj := map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"spec":       s.spec,
+			}
+			errs, err := ValidateAgainstCRDSchema(crdSchema, obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := []string{}
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			sort.Strings(fields)
+			if len(fields) != len(s.errors) || (len(fields) > 0 && !reflect.DeepEqual(fields, s.errors)) {
+				t.Fatalf("unexpected validation errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestManifestAttributePath(t *testing.T) {
+	samples := map[string]*tftypes.AttributePath{
+		"": tftypes.NewAttributePath().WithAttributeName("manifest"),
+		"spec.ports[0].port": tftypes.NewAttributePath().WithAttributeName("manifest").
+			WithAttributeName("spec").
+			WithAttributeName("ports").
+			WithElementKeyInt(0).
+			WithAttributeName("port"),
+		`metadata.labels["app"]`: tftypes.NewAttributePath().WithAttributeName("manifest").
+			WithAttributeName("metadata").
+			WithAttributeName("labels").
+			WithElementKeyString("app"),
+	}
+	for in, out := range samples {
+		t.Run(in, func(t *testing.T) {
+			p := manifestAttributePath(in)
+			if !p.Equal(out) {
+				t.Fatalf("expected %s, got %s", out, p)
+			}
+		})
+	}
+}
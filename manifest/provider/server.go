@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -37,6 +39,11 @@ type RawProviderServer struct {
 	restClient          rest.Interface
 	OAPIFoundry         openapi.Foundry
 
+	// crdSchemas caches the OpenAPI v3 schemas of the CRDs that manifests
+	// were validated against, keyed by the GroupVersionKind they serve.
+	crdSchemas     map[schema.GroupVersionKind]interface{}
+	crdSchemasLock sync.Mutex
+
 	hostTFVersion string
 }
 
@@ -106,6 +106,36 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 		}
 	}
 
+	// validate ignore_fields
+	ignoreFields, err := getIgnoreFields(configVal)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid ignore_fields configuration",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields"),
+		})
+	}
+	_, err = tftypes.Transform(manifest, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsNull() && isIgnoredPath(ap, ignoreFields) {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Ignored field set in manifest",
+				Detail:    "Fields listed in ignore_fields are not applied by Terraform and cannot be set in the manifest.",
+				Attribute: tftypes.NewAttributePathWithSteps(append(att.Steps(), ap.Steps()...)),
+			})
+		}
+		return v, nil
+	})
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to check manifest for ignored fields",
+			Detail:    err.Error(),
+			Attribute: att,
+		})
+	}
+
 	// validate timeouts block
 	timeouts := s.getTimeouts(configVal)
 	path := tftypes.NewAttributePath().WithAttributeName("timeouts")
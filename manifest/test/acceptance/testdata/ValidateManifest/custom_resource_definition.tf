@@ -0,0 +1,48 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "kubernetes_manifest" "test_crd" {
+  manifest = {
+    apiVersion = "apiextensions.k8s.io/v1"
+    kind       = "CustomResourceDefinition"
+    metadata = {
+      name = "${var.plural}.${var.group}"
+    }
+    spec = {
+      group = var.group
+      names = {
+        kind   = var.kind
+        plural = var.plural
+      }
+      scope = "Namespaced"
+      versions = [
+        {
+          name    = var.cr_version
+          served  = true
+          storage = true
+          schema = {
+            openAPIV3Schema = {
+              type = "object"
+              properties = {
+                spec = {
+                  type     = "object"
+                  required = ["size"]
+                  properties = {
+                    size = {
+                      type    = "integer"
+                      minimum = 1
+                    }
+                    mode = {
+                      type = "string"
+                      enum = ["fast", "safe"]
+                    }
+                  }
+                }
+              }
+            }
+          }
+        },
+      ]
+    }
+  }
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build acceptance
+// +build acceptance
+
+package acceptance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/provider"
+	tfstatehelper "github.com/hashicorp/terraform-provider-kubernetes/manifest/test/helper/state"
+)
+
+func TestKubernetesManifest_ValidateManifest(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	kind := strings.Title(randString(8))
+	plural := strings.ToLower(kind) + "s"
+	group := "terraform.io"
+	version := "v1"
+	groupVersion := group + "/" + version
+	crd := fmt.Sprintf("%s.%s", plural, group)
+
+	name := strings.ToLower(randName())
+	namespace := "default"
+
+	tfvars := TFVARS{
+		"name":       name,
+		"namespace":  namespace,
+		"kind":       kind,
+		"plural":     plural,
+		"group":      group,
+		"cr_version": version,
+	}
+
+	step1 := tfhelper.RequireNewWorkingDir(ctx, t)
+	step1.SetReattachInfo(ctx, reattachInfo)
+	defer func() {
+		step1.Destroy(ctx)
+		step1.Close()
+		k8shelper.AssertResourceDoesNotExist(t, "apiextensions.k8s.io/v1", "customresourcedefinitions", crd)
+	}()
+
+	tfconfig := loadTerraformConfig(t, "ValidateManifest/custom_resource_definition.tf", tfvars)
+	step1.SetConfig(ctx, string(tfconfig))
+	step1.Init(ctx)
+	step1.Apply(ctx)
+	k8shelper.AssertResourceExists(t, "apiextensions.k8s.io/v1", "customresourcedefinitions", crd)
+
+	// wait for API to finish ingesting the CRD
+	time.Sleep(5 * time.Second) //lintignore:R018
+
+	reattachInfo2, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+	step2 := tfhelper.RequireNewWorkingDir(ctx, t)
+	step2.SetReattachInfo(ctx, reattachInfo2)
+	defer func() {
+		step2.Destroy(ctx)
+		step2.Close()
+		k8shelper.AssertNamespacedResourceDoesNotExist(t, groupVersion, plural, namespace, name)
+	}()
+
+	// 1. A custom resource that violates the CRD schema is rejected during planning
+	tfvars["mode"] = "slow"
+	tfconfig = loadTerraformConfig(t, "ValidateManifest/custom_resource.tf", tfvars)
+	step2.SetConfig(ctx, string(tfconfig))
+	step2.Init(ctx)
+	err = step2.CreatePlan(ctx)
+	if err == nil || !strings.Contains(err.Error(), "Manifest does not match the CRD schema") {
+		t.Fatalf("Expected terraform plan to fail the CRD schema validation, got: %v", err)
+	}
+	k8shelper.AssertNamespacedResourceDoesNotExist(t, groupVersion, plural, namespace, name)
+
+	// 2. A valid custom resource is created
+	tfvars["mode"] = "safe"
+	tfconfig = loadTerraformConfig(t, "ValidateManifest/custom_resource.tf", tfvars)
+	step2.SetConfig(ctx, string(tfconfig))
+	step2.Apply(ctx)
+	k8shelper.AssertNamespacedResourceExists(t, groupVersion, plural, namespace, name)
+
+	s, err := step2.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(s)
+	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
+		"kubernetes_manifest.test.object.metadata.name": name,
+		"kubernetes_manifest.test.object.spec.mode":     "safe",
+		"kubernetes_manifest.test.validate_manifest":    true,
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
@@ -119,6 +120,16 @@ func (k *Helper) CreateConfigMap(t *testing.T, name string, namespace string, da
 	}
 }
 
+// MergePatchNamespacedResource applies a JSON merge patch to a namespaced resource
+func (k *Helper) MergePatchNamespacedResource(t *testing.T, name string, namespace string, gvr schema.GroupVersionResource, patch string) {
+	t.Helper()
+
+	_, err := k.dynClient.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		t.Fatalf("Failed to patch resource \"%s/%s\": %v", namespace, name, err)
+	}
+}
+
 // CreatePod creates a new Pod
 func (k *Helper) CreatePod(t *testing.T, name, namespace string, podSpec corev1.PodSpec) {
 	t.Helper()